@@ -1,9 +1,11 @@
 package validate
 
 import (
+	"encoding/binary"
 	"fmt"
 	"net"
 	"regexp"
+	"sort"
 )
 
 // CIDR is a SchemaValidateFunc which tests if the provided value is a valid IPv4 CIDR
@@ -70,3 +72,91 @@ func validatePortNumber(i interface{}, k string, allowZero bool) (warnings []str
 
 	return warnings, errors
 }
+
+type ipv4Range struct {
+	start uint64
+	end   uint64
+}
+
+func parseIPv4Range(cidr string) (*ipv4Range, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a CIDR: %+v", cidr, err)
+	}
+
+	ip := network.IP.To4()
+	if ip == nil {
+		return nil, nil
+	}
+
+	ones, _ := network.Mask.Size()
+	start := uint64(binary.BigEndian.Uint32(ip))
+	return &ipv4Range{
+		start: start,
+		end:   start + (uint64(1) << uint(32-ones)) - 1,
+	}, nil
+}
+
+// FreeIPv4CIDRs returns up to `count` CIDR ranges with the specified prefix length which fall within the specified
+// address spaces but which don't overlap any of the used ranges. IPv6 address spaces and used ranges are ignored.
+func FreeIPv4CIDRs(addressSpaces []string, usedRanges []string, prefixLength int, count int) ([]string, error) {
+	if prefixLength < 0 || prefixLength > 32 {
+		return nil, fmt.Errorf("prefix length must be between 0 and 32 but got %d", prefixLength)
+	}
+
+	used := make([]ipv4Range, 0)
+	for _, v := range usedRanges {
+		r, err := parseIPv4Range(v)
+		if err != nil {
+			return nil, err
+		}
+		if r != nil {
+			used = append(used, *r)
+		}
+	}
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].start < used[j].start
+	})
+
+	size := uint64(1) << uint(32-prefixLength)
+	results := make([]string, 0)
+	for _, v := range addressSpaces {
+		space, err := parseIPv4Range(v)
+		if err != nil {
+			return nil, err
+		}
+		if space == nil {
+			continue
+		}
+
+		// the address space is aligned to its own prefix length, so any range which is larger won't fit within it
+		current := space.start
+		for current+size-1 <= space.end && len(results) < count {
+			end := current + size - 1
+
+			overlapping := false
+			for _, r := range used {
+				if r.start <= end && current <= r.end {
+					// skip to the next aligned range after the overlapping range
+					current = (r.end/size + 1) * size
+					overlapping = true
+					break
+				}
+			}
+			if overlapping {
+				continue
+			}
+
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, uint32(current))
+			results = append(results, fmt.Sprintf("%s/%d", ip.String(), prefixLength))
+			current += size
+		}
+
+		if len(results) >= count {
+			break
+		}
+	}
+
+	return results, nil
+}
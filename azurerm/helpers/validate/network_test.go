@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"reflect"
 	"strconv"
 	"testing"
 )
@@ -234,3 +235,137 @@ func TestPortNumberOrZero(t *testing.T) {
 		})
 	}
 }
+
+func TestFreeIPv4CIDRs(t *testing.T) {
+	cases := []struct {
+		Name          string
+		AddressSpaces []string
+		UsedRanges    []string
+		PrefixLength  int
+		Count         int
+		Expected      []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "empty address space",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLength:  24,
+			Count:         2,
+			Expected:      []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		{
+			Name:          "skips used ranges",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedRanges:    []string{"10.0.0.0/24", "10.0.1.0/25"},
+			PrefixLength:  24,
+			Count:         1,
+			Expected:      []string{"10.0.2.0/24"},
+		},
+		{
+			Name:          "fills gaps between used ranges",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedRanges:    []string{"10.0.0.0/26", "10.0.0.128/26"},
+			PrefixLength:  26,
+			Count:         3,
+			Expected:      []string{"10.0.0.64/26", "10.0.0.192/26"},
+		},
+		{
+			Name:          "used range larger than requested range",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedRanges:    []string{"10.0.0.0/20"},
+			PrefixLength:  28,
+			Count:         1,
+			Expected:      []string{"10.0.16.0/28"},
+		},
+		{
+			Name:          "unaligned used range",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedRanges:    []string{"10.0.0.4/30"},
+			PrefixLength:  28,
+			Count:         1,
+			Expected:      []string{"10.0.0.16/28"},
+		},
+		{
+			Name:          "multiple address spaces",
+			AddressSpaces: []string{"10.0.0.0/24", "192.168.0.0/24"},
+			UsedRanges:    []string{"10.0.0.0/24"},
+			PrefixLength:  25,
+			Count:         1,
+			Expected:      []string{"192.168.0.0/25"},
+		},
+		{
+			Name:          "requested range larger than address space",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			PrefixLength:  16,
+			Count:         1,
+			Expected:      []string{},
+		},
+		{
+			Name:          "address space is full",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			UsedRanges:    []string{"10.0.0.0/25", "10.0.0.128/25"},
+			PrefixLength:  29,
+			Count:         1,
+			Expected:      []string{},
+		},
+		{
+			Name:          "end of the IPv4 address space",
+			AddressSpaces: []string{"255.255.255.0/24"},
+			UsedRanges:    []string{"255.255.255.0/25"},
+			PrefixLength:  25,
+			Count:         2,
+			Expected:      []string{"255.255.255.128/25"},
+		},
+		{
+			Name:          "IPv6 ranges are ignored",
+			AddressSpaces: []string{"ace:cab:deca::/48", "10.0.0.0/24"},
+			UsedRanges:    []string{"ace:cab:deca:deed::/64"},
+			PrefixLength:  24,
+			Count:         1,
+			Expected:      []string{"10.0.0.0/24"},
+		},
+		{
+			Name:          "invalid address space",
+			AddressSpaces: []string{"10.0.0.0"},
+			PrefixLength:  24,
+			Count:         1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "invalid used range",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			UsedRanges:    []string{"10.0.0.0/33"},
+			PrefixLength:  24,
+			Count:         1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "invalid prefix length",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLength:  33,
+			Count:         1,
+			ExpectError:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual, err := FreeIPv4CIDRs(tc.AddressSpaces, tc.UsedRanges, tc.PrefixLength, tc.Count)
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if tc.ExpectError {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
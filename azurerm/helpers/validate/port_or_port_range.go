@@ -6,6 +6,8 @@ import (
 	"strconv"
 )
 
+var portOrPortRangeRegExp = regexp.MustCompile(`^(\d+)((-)(\d+))?$`)
+
 func PortOrPortRangeWithin(min int, max int) func(interface{}, string) ([]string, []error) {
 	return func(i interface{}, k string) (warnings []string, errors []error) {
 		v, ok := i.(string)
@@ -23,7 +25,7 @@ func PortOrPortRangeWithin(min int, max int) func(interface{}, string) ([]string
 		}
 
 		// Allowed format including: `num` or `num1-num2` (num1 < num2).
-		groups := regexp.MustCompile(`^(\d+)((-)(\d+))?$`).FindStringSubmatch(v)
+		groups := portOrPortRangeRegExp.FindStringSubmatch(v)
 		if len(groups) != 5 {
 			errors = append(errors, fmt.Errorf("expected `number` or `num1-num2` but got %q", v))
 			return
@@ -59,3 +61,41 @@ func PortOrPortRangeWithin(min int, max int) func(interface{}, string) ([]string
 		return nil, nil
 	}
 }
+
+// PortRangesDoNotOverlap validates that none of the ports or port ranges
+// (in the format `num` or `num1-num2`) within input overlap one another
+func PortRangesDoNotOverlap(input []string) error {
+	type portRange struct {
+		value string
+		start int
+		end   int
+	}
+
+	ranges := make([]portRange, 0, len(input))
+	for _, v := range input {
+		groups := portOrPortRangeRegExp.FindStringSubmatch(v)
+		if len(groups) != 5 {
+			return fmt.Errorf("expected `number` or `num1-num2` but got %q", v)
+		}
+
+		start, _ := strconv.Atoi(groups[1])
+		end := start
+		if groups[2] != "" {
+			end, _ = strconv.Atoi(groups[4])
+		}
+
+		for _, existing := range ranges {
+			if start <= existing.end && existing.start <= end {
+				return fmt.Errorf("port range %q overlaps with port range %q", v, existing.value)
+			}
+		}
+
+		ranges = append(ranges, portRange{
+			value: v,
+			start: start,
+			end:   end,
+		})
+	}
+
+	return nil
+}
@@ -49,3 +49,60 @@ func TestPortOrPortRangeWithin(t *testing.T) {
 		}
 	}
 }
+
+func TestPortRangesDoNotOverlap(t *testing.T) {
+	testData := []struct {
+		input    []string
+		expected bool
+	}{
+		{
+			input:    []string{},
+			expected: true,
+		},
+		{
+			input:    []string{"80"},
+			expected: true,
+		},
+		{
+			input:    []string{"80", "443"},
+			expected: true,
+		},
+		{
+			input:    []string{"80", "80"},
+			expected: false,
+		},
+		{
+			input:    []string{"1000-2000", "2001-3000"},
+			expected: true,
+		},
+		{
+			input:    []string{"1000-2000", "2000-3000"},
+			expected: false,
+		},
+		{
+			input:    []string{"1000-2000", "1500"},
+			expected: false,
+		},
+		{
+			input:    []string{"1500", "1000-2000"},
+			expected: false,
+		},
+		{
+			input:    []string{"1000-5000", "2000-3000"},
+			expected: false,
+		},
+		{
+			input:    []string{"abc"},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual := PortRangesDoNotOverlap(v.input) == nil
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/galleryimageversions"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/restorepointcollections"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/restorepoints"
 )
 
 type Client struct {
@@ -13,12 +16,16 @@ type Client struct {
 	DisksClient                     *compute.DisksClient
 	DiskAccessClient                *compute.DiskAccessesClient
 	DiskEncryptionSetsClient        *compute.DiskEncryptionSetsClient
+	DiskRestorePointClient          *compute.DiskRestorePointClient
 	GalleriesClient                 *compute.GalleriesClient
 	GalleryImagesClient             *compute.GalleryImagesClient
 	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
+	GalleryImageVersionsSDKClient   *galleryimageversions.GalleryImageVersionsClient
 	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
 	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
 	ImagesClient                    *compute.ImagesClient
+	RestorePointCollectionsClient   *restorepointcollections.RestorePointCollectionsClient
+	RestorePointsClient             *restorepoints.RestorePointsClient
 	SnapshotsClient                 *compute.SnapshotsClient
 	UsageClient                     *compute.UsageClient
 	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
@@ -51,6 +58,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	diskEncryptionSetsClient := compute.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&diskEncryptionSetsClient.Client, o.ResourceManagerAuthorizer)
 
+	diskRestorePointClient := compute.NewDiskRestorePointClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&diskRestorePointClient.Client, o.ResourceManagerAuthorizer)
+
 	galleriesClient := compute.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleriesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -60,6 +70,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
 
+	galleryImageVersionsSDKClient := galleryimageversions.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&galleryImageVersionsSDKClient.Client, o.ResourceManagerAuthorizer)
+
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -99,6 +112,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	vmClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vmClient.Client, o.ResourceManagerAuthorizer)
 
+	restorePointCollectionsClient := restorepointcollections.NewRestorePointCollectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&restorePointCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	restorePointsClient := restorepoints.NewRestorePointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&restorePointsClient.Client, o.ResourceManagerAuthorizer)
+
 	sshPublicKeysClient := compute.NewSSHPublicKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sshPublicKeysClient.Client, o.ResourceManagerAuthorizer)
 
@@ -109,12 +128,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		DisksClient:                     &disksClient,
 		DiskAccessClient:                &diskAccessClient,
 		DiskEncryptionSetsClient:        &diskEncryptionSetsClient,
+		DiskRestorePointClient:          &diskRestorePointClient,
 		GalleriesClient:                 &galleriesClient,
 		GalleryImagesClient:             &galleryImagesClient,
 		GalleryImageVersionsClient:      &galleryImageVersionsClient,
+		GalleryImageVersionsSDKClient:   &galleryImageVersionsSDKClient,
 		ImagesClient:                    &imagesClient,
 		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
 		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
+		RestorePointCollectionsClient:   &restorePointCollectionsClient,
+		RestorePointsClient:             &restorePointsClient,
 		SnapshotsClient:                 &snapshotsClient,
 		UsageClient:                     &usageClient,
 		VMExtensionImageClient:          &vmExtensionImageClient,
@@ -0,0 +1,210 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/restorepoints"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceDiskRestorePoints() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDiskRestorePointsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"restore_point_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"restore_point_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"disk_restore_points": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source_unique_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"family_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"os_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"hyper_v_generation": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"encryption_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"disk_encryption_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"supports_hibernation": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"time_created": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDiskRestorePointsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DiskRestorePointClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := restorepoints.NewRestorePointID(subscriptionId, d.Get("resource_group_name").(string), d.Get("restore_point_collection_name").(string), d.Get("restore_point_name").(string))
+
+	iterator, err := client.ListByRestorePointComplete(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(iterator.Response().Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("listing Disk Restore Points for %s: %+v", id, err)
+	}
+
+	restorePoints := make([]compute.DiskRestorePoint, 0)
+	for iterator.NotDone() {
+		restorePoints = append(restorePoints, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing next page of Disk Restore Points for %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("restore_point_collection_name", id.RestorePointCollectionName)
+	d.Set("restore_point_name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("disk_restore_points", flattenDiskRestorePoints(restorePoints)); err != nil {
+		return fmt.Errorf("setting `disk_restore_points`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDiskRestorePoints(input []compute.DiskRestorePoint) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		sourceResourceId := ""
+		sourceUniqueId := ""
+		familyId := ""
+		osType := ""
+		hyperVGeneration := ""
+		encryptionType := ""
+		diskEncryptionSetId := ""
+		supportsHibernation := false
+		timeCreated := ""
+		if props := item.DiskRestorePointProperties; props != nil {
+			if props.SourceResourceID != nil {
+				sourceResourceId = *props.SourceResourceID
+			}
+			if props.SourceUniqueID != nil {
+				sourceUniqueId = *props.SourceUniqueID
+			}
+			if props.FamilyID != nil {
+				familyId = *props.FamilyID
+			}
+			osType = string(props.OsType)
+			hyperVGeneration = string(props.HyperVGeneration)
+
+			if encryption := props.Encryption; encryption != nil {
+				encryptionType = string(encryption.Type)
+				if encryption.DiskEncryptionSetID != nil {
+					diskEncryptionSetId = *encryption.DiskEncryptionSetID
+				}
+			}
+
+			if props.SupportsHibernation != nil {
+				supportsHibernation = *props.SupportsHibernation
+			}
+			if props.TimeCreated != nil {
+				timeCreated = props.TimeCreated.Format(time.RFC3339)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                     id,
+			"name":                   name,
+			"source_resource_id":     sourceResourceId,
+			"source_unique_id":       sourceUniqueId,
+			"family_id":              familyId,
+			"os_type":                osType,
+			"hyper_v_generation":     hyperVGeneration,
+			"encryption_type":        encryptionType,
+			"disk_encryption_set_id": diskEncryptionSetId,
+			"supports_hibernation":   supportsHibernation,
+			"time_created":           timeCreated,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type DiskRestorePointsDataSource struct {
+}
+
+func TestAccDataSourceDiskRestorePoints_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_disk_restore_points", "test")
+	r := DiskRestorePointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("disk_restore_points.#").HasValue("1"),
+				check.That(data.ResourceName).Key("disk_restore_points.0.id").Exists(),
+				check.That(data.ResourceName).Key("disk_restore_points.0.source_resource_id").Exists(),
+				check.That(data.ResourceName).Key("disk_restore_points.0.os_type").HasValue("Linux"),
+			),
+		},
+	})
+}
+
+func (DiskRestorePointsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_disk_restore_points" "test" {
+  restore_point_collection_name = azurerm_restore_point_collection.test.name
+  restore_point_name            = azurerm_virtual_machine_restore_point.test.name
+  resource_group_name           = azurerm_resource_group.test.name
+}
+`, VirtualMachineRestorePointResource{}.basic(data))
+}
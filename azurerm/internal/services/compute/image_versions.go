@@ -0,0 +1,65 @@
+package compute
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// compareImageVersions compares two Image Versions (e.g. `18.04.202107200` or `1.2.3`) segment by segment,
+// where numeric segments are compared numerically and anything else lexically - returning -1 when `a` is
+// older than `b`, 1 when `a` is newer than `b` and 0 when they're equal.
+func compareImageVersions(a, b string) int {
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		aSegment := aSegments[i]
+		bSegment := bSegments[i]
+
+		aNumber, aErr := strconv.ParseUint(aSegment, 10, 64)
+		bNumber, bErr := strconv.ParseUint(bSegment, 10, 64)
+		if aErr == nil && bErr == nil {
+			if aNumber < bNumber {
+				return -1
+			}
+			if aNumber > bNumber {
+				return 1
+			}
+			continue
+		}
+
+		// a numeric segment is considered newer than a non-numeric one (e.g. `1.0.0` vs `1.0.beta`)
+		if aErr == nil {
+			return 1
+		}
+		if bErr == nil {
+			return -1
+		}
+
+		if c := strings.Compare(aSegment, bSegment); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case len(aSegments) < len(bSegments):
+		return -1
+	case len(aSegments) > len(bSegments):
+		return 1
+	}
+
+	return 0
+}
+
+// sortImageVersionsNewestFirst sorts the specified Image Versions in place from newest to oldest
+func sortImageVersionsNewestFirst(input []string) {
+	sort.SliceStable(input, func(i, j int) bool {
+		return compareImageVersions(input[i], input[j]) > 0
+	})
+}
+
+// isPreviewImageName returns whether the specified Offer/SKU name denotes a Preview image
+func isPreviewImageName(input string) bool {
+	return strings.Contains(strings.ToLower(input), "preview")
+}
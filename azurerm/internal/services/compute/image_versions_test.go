@@ -0,0 +1,102 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompareImageVersions(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{
+			A:        "1.0.0",
+			B:        "1.0.0",
+			Expected: 0,
+		},
+		{
+			A:        "1.0.1",
+			B:        "1.0.0",
+			Expected: 1,
+		},
+		{
+			// compared numerically, rather than lexically
+			A:        "1.0.9",
+			B:        "1.0.10",
+			Expected: -1,
+		},
+		{
+			A:        "18.04.202107200",
+			B:        "18.04.202106220",
+			Expected: 1,
+		},
+		{
+			A:        "1234567890.1234567890.1234567890",
+			B:        "1.2.3",
+			Expected: 1,
+		},
+		{
+			A:        "1.0",
+			B:        "1.0.0",
+			Expected: -1,
+		},
+		{
+			A:        "1.0.0",
+			B:        "1.0.beta",
+			Expected: 1,
+		},
+		{
+			A:        "1.0.alpha",
+			B:        "1.0.beta",
+			Expected: -1,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q vs %q", v.A, v.B)
+
+		if actual := compareImageVersions(v.A, v.B); actual != v.Expected {
+			t.Fatalf("Expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
+
+func TestSortImageVersionsNewestFirst(t *testing.T) {
+	input := []string{
+		"1.0.10",
+		"1.2.0",
+		"1.0.9",
+		"2.0.0",
+		"1.0.10",
+	}
+	expected := []string{
+		"2.0.0",
+		"1.2.0",
+		"1.0.10",
+		"1.0.10",
+		"1.0.9",
+	}
+
+	sortImageVersionsNewestFirst(input)
+
+	if !reflect.DeepEqual(input, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, input)
+	}
+}
+
+func TestIsPreviewImageName(t *testing.T) {
+	cases := map[string]bool{
+		"UbuntuServer":                 false,
+		"0001-com-ubuntu-server-focal": false,
+		"20_04-lts-preview":            true,
+		"WindowsServer-Preview":        true,
+	}
+
+	for input, expected := range cases {
+		if actual := isPreviewImageName(input); actual != expected {
+			t.Fatalf("Expected %t for %q but got %t", expected, input, actual)
+		}
+	}
+}
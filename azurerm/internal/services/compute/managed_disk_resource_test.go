@@ -105,6 +105,22 @@ func TestAccManagedDisk_copy(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_restore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.restore(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("create_option").HasValue("Restore"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagedDisk_fromPlatformImage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -619,6 +635,21 @@ resource "azurerm_managed_disk" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ManagedDiskResource) restore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Restore"
+  source_resource_id   = data.azurerm_disk_restore_points.test.disk_restore_points.0.id
+}
+`, DiskRestorePointsDataSource{}.basic(data), data.RandomInteger)
+}
+
 func (ManagedDiskResource) empty_updated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,184 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceManagedDiskSasToken() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceManagedDiskSasTokenCreate,
+		Read:   resourceManagedDiskSasTokenRead,
+		Delete: resourceManagedDiskSasTokenDelete,
+
+		// the SAS URL is only returned when access is granted, so this can't be imported
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"managed_disk_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedDiskID,
+			},
+
+			"duration_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, math.MaxInt32),
+			},
+
+			"access_level": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Read),
+					string(compute.Write),
+				}, false),
+			},
+
+			"sas_url": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceManagedDiskSasTokenCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DisksClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	diskId, err := parse.ManagedDiskID(d.Get("managed_disk_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(diskId.ID())
+	defer locks.UnlockByID(diskId.ID())
+
+	disk, err := client.Get(ctx, diskId.ResourceGroup, diskId.DiskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(disk.Response) {
+			return fmt.Errorf("%s was not found", *diskId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *diskId, err)
+	}
+
+	// a disk can only have a single SAS granted at any one time, which we'd otherwise silently replace
+	if props := disk.DiskProperties; props != nil && props.DiskState == compute.ActiveSAS {
+		return fmt.Errorf("%s already has an active SAS - this must be revoked before a new SAS can be granted", *diskId)
+	}
+
+	grantAccessData := compute.GrantAccessData{
+		Access:            compute.AccessLevel(d.Get("access_level").(string)),
+		DurationInSeconds: utils.Int32(int32(d.Get("duration_in_seconds").(int))),
+	}
+
+	future, err := client.GrantAccess(ctx, diskId.ResourceGroup, diskId.DiskName, grantAccessData)
+	if err != nil {
+		return fmt.Errorf("granting access to %s: %+v", *diskId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for access to be granted to %s: %+v", *diskId, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the SAS URL for %s: %+v", *diskId, err)
+	}
+
+	if result.AccessSAS == nil || *result.AccessSAS == "" {
+		return fmt.Errorf("retrieving the SAS URL for %s: `accessSAS` was nil", *diskId)
+	}
+
+	d.SetId(parse.NewManagedDiskSasTokenID(diskId.SubscriptionId, diskId.ResourceGroup, diskId.DiskName).ID())
+	d.Set("sas_url", result.AccessSAS)
+
+	return resourceManagedDiskSasTokenRead(d, meta)
+}
+
+func resourceManagedDiskSasTokenRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DisksClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedDiskSasTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+	diskId := id.ManagedDiskID()
+
+	resp, err := client.Get(ctx, diskId.ResourceGroup, diskId.DiskName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing the SAS Token from state", diskId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", diskId, err)
+	}
+
+	// once the SAS has expired or been revoked the disk moves out of the `ActiveSAS` state
+	if props := resp.DiskProperties; props == nil || props.DiskState != compute.ActiveSAS {
+		log.Printf("[DEBUG] %s no longer has an active SAS - removing the SAS Token from state", diskId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("managed_disk_id", diskId.ID())
+
+	return nil
+}
+
+func resourceManagedDiskSasTokenDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DisksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedDiskSasTokenID(d.Id())
+	if err != nil {
+		return err
+	}
+	diskId := id.ManagedDiskID()
+
+	locks.ByID(diskId.ID())
+	defer locks.UnlockByID(diskId.ID())
+
+	future, err := client.RevokeAccess(ctx, diskId.ResourceGroup, diskId.DiskName)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("revoking access to %s: %+v", diskId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for access to be revoked from %s: %+v", diskId, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ManagedDiskSasTokenResource struct {
+}
+
+func TestAccManagedDiskSasToken_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk_sas_token", "test")
+	r := ManagedDiskSasTokenResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sas_url").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccManagedDiskSasToken_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk_sas_token", "test")
+	r := ManagedDiskSasTokenResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: regexp.MustCompile("already has an active SAS"),
+		},
+	})
+}
+
+func (ManagedDiskSasTokenResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedDiskSasTokenID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	diskId := id.ManagedDiskID()
+
+	resp, err := clients.Compute.DisksClient.Get(ctx, diskId.ResourceGroup, diskId.DiskName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", diskId, err)
+	}
+
+	return utils.Bool(resp.DiskProperties != nil && resp.DiskProperties.DiskState == compute.ActiveSAS), nil
+}
+
+func (ManagedDiskSasTokenResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk_sas_token" "test" {
+  managed_disk_id     = azurerm_managed_disk.test.id
+  duration_in_seconds = 300
+  access_level        = "Read"
+}
+`, ManagedDiskResource{}.empty(data))
+}
+
+func (r ManagedDiskSasTokenResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk_sas_token" "import" {
+  managed_disk_id     = azurerm_managed_disk_sas_token.test.managed_disk_id
+  duration_in_seconds = azurerm_managed_disk_sas_token.test.duration_in_seconds
+  access_level        = azurerm_managed_disk_sas_token.test.access_level
+}
+`, r.basic(data))
+}
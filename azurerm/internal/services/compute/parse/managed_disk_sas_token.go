@@ -0,0 +1,57 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This is manual since the ID of a Managed Disk SAS Token is the ID of the Managed Disk with a `/sasToken` suffix,
+// which isn't supported in auto-generation
+
+const managedDiskSasTokenSuffix = "/sasToken"
+
+type ManagedDiskSasTokenId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	DiskName       string
+}
+
+func NewManagedDiskSasTokenID(subscriptionId, resourceGroup, diskName string) ManagedDiskSasTokenId {
+	return ManagedDiskSasTokenId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		DiskName:       diskName,
+	}
+}
+
+func (id ManagedDiskSasTokenId) String() string {
+	segments := []string{
+		fmt.Sprintf("Disk Name %q", id.DiskName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed Disk SAS Token", segmentsStr)
+}
+
+func (id ManagedDiskSasTokenId) ID() string {
+	return id.ManagedDiskID().ID() + managedDiskSasTokenSuffix
+}
+
+func (id ManagedDiskSasTokenId) ManagedDiskID() ManagedDiskId {
+	return NewManagedDiskID(id.SubscriptionId, id.ResourceGroup, id.DiskName)
+}
+
+// ManagedDiskSasTokenID parses a ManagedDiskSasToken ID into an ManagedDiskSasTokenId struct
+func ManagedDiskSasTokenID(input string) (*ManagedDiskSasTokenId, error) {
+	if !strings.HasSuffix(input, managedDiskSasTokenSuffix) {
+		return nil, fmt.Errorf("ID was missing the %q suffix", managedDiskSasTokenSuffix)
+	}
+
+	diskId, err := ManagedDiskID(strings.TrimSuffix(input, managedDiskSasTokenSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NewManagedDiskSasTokenID(diskId.SubscriptionId, diskId.ResourceGroup, diskId.DiskName)
+	return &resourceId, nil
+}
@@ -0,0 +1,227 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourcePlatformImages() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePlatformImagesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": azure.SchemaLocation(),
+
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"sku_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"version_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"exclude_preview": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_versions_per_sku": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"images": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"offer": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sku": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type platformImagesFilter struct {
+	offer          *regexp.Regexp
+	sku            *regexp.Regexp
+	version        *regexp.Regexp
+	excludePreview bool
+	maxVersions    int
+}
+
+func dataSourcePlatformImagesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMImageClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	publisher := d.Get("publisher").(string)
+
+	filter := platformImagesFilter{
+		excludePreview: d.Get("exclude_preview").(bool),
+		maxVersions:    d.Get("max_versions_per_sku").(int),
+	}
+	// these have been validated at plan time, so can't fail to compile
+	if v := d.Get("offer_regex").(string); v != "" {
+		filter.offer = regexp.MustCompile(v)
+	}
+	if v := d.Get("sku_regex").(string); v != "" {
+		filter.sku = regexp.MustCompile(v)
+	}
+	if v := d.Get("version_regex").(string); v != "" {
+		filter.version = regexp.MustCompile(v)
+	}
+
+	images, err := listPlatformImages(ctx, client, location, publisher, filter)
+	if err != nil {
+		return err
+	}
+
+	if len(images) == 0 {
+		return fmt.Errorf("no Platform Images were found for Publisher %q in %q matching the specified filters", publisher, location)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("location", location)
+	d.Set("publisher", publisher)
+
+	if err := d.Set("images", images); err != nil {
+		return fmt.Errorf("setting `images`: %+v", err)
+	}
+
+	return nil
+}
+
+func listPlatformImages(ctx context.Context, client *compute.VirtualMachineImagesClient, location, publisher string, filter platformImagesFilter) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	offers, err := client.ListOffers(ctx, location, publisher)
+	if err != nil {
+		return nil, fmt.Errorf("listing Offers for Publisher %q in %q: %+v", publisher, location, err)
+	}
+
+	for _, offer := range platformImageNames(offers.Value, filter.offer, filter.excludePreview) {
+		skus, err := client.ListSkus(ctx, location, publisher, offer)
+		if err != nil {
+			return nil, fmt.Errorf("listing SKUs for Offer %q (Publisher %q) in %q: %+v", offer, publisher, location, err)
+		}
+
+		for _, sku := range platformImageNames(skus.Value, filter.sku, filter.excludePreview) {
+			versions, err := client.List(ctx, location, publisher, offer, sku, "", nil, "")
+			if err != nil {
+				return nil, fmt.Errorf("listing Versions for SKU %q (Offer %q / Publisher %q) in %q: %+v", sku, offer, publisher, location, err)
+			}
+
+			ids := make(map[string]string)
+			names := make([]string, 0)
+			if versions.Value != nil {
+				for _, item := range *versions.Value {
+					if item.Name == nil {
+						continue
+					}
+					if filter.version != nil && !filter.version.MatchString(*item.Name) {
+						continue
+					}
+
+					id := ""
+					if item.ID != nil {
+						id = *item.ID
+					}
+					ids[*item.Name] = id
+					names = append(names, *item.Name)
+				}
+			}
+
+			sortImageVersionsNewestFirst(names)
+			if filter.maxVersions > 0 && len(names) > filter.maxVersions {
+				names = names[:filter.maxVersions]
+			}
+
+			for _, version := range names {
+				results = append(results, map[string]interface{}{
+					"id":      ids[version],
+					"offer":   offer,
+					"sku":     sku,
+					"version": version,
+				})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func platformImageNames(input *[]compute.VirtualMachineImageResource, filter *regexp.Regexp, excludePreview bool) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Name == nil {
+			continue
+		}
+		if excludePreview && isPreviewImageName(*item.Name) {
+			continue
+		}
+		if filter != nil && !filter.MatchString(*item.Name) {
+			continue
+		}
+
+		results = append(results, *item.Name)
+	}
+
+	return results
+}
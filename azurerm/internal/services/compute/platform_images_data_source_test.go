@@ -0,0 +1,78 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type PlatformImagesDataSource struct {
+}
+
+func TestAccDataSourcePlatformImages_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_platform_images", "test")
+	r := PlatformImagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").Exists(),
+				check.That(data.ResourceName).Key("images.0.offer").HasValue("UbuntuServer"),
+				check.That(data.ResourceName).Key("images.0.sku").HasValue("16.04-LTS"),
+				check.That(data.ResourceName).Key("images.0.version").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePlatformImages_latestVersions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_platform_images", "test")
+	r := PlatformImagesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.latestVersions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("2"),
+				check.That(data.ResourceName).Key("images.0.sku").HasValue("16.04-LTS"),
+				check.That(data.ResourceName).Key("images.1.sku").HasValue("16.04-LTS"),
+			),
+		},
+	})
+}
+
+func (PlatformImagesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_platform_images" "test" {
+  location        = "%s"
+  publisher       = "Canonical"
+  offer_regex     = "^UbuntuServer$"
+  sku_regex       = "^16.04-LTS$"
+  exclude_preview = true
+}
+`, data.Locations.Primary)
+}
+
+func (PlatformImagesDataSource) latestVersions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_platform_images" "test" {
+  location             = "%s"
+  publisher            = "Canonical"
+  offer_regex          = "^UbuntuServer$"
+  sku_regex            = "^16.04-LTS$"
+  version_regex        = "^16\\.04\\."
+  max_versions_per_sku = 2
+}
+`, data.Locations.Primary)
+}
@@ -25,11 +25,13 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_dedicated_host":            dataSourceDedicatedHost(),
 		"azurerm_dedicated_host_group":      dataSourceDedicatedHostGroup(),
 		"azurerm_disk_encryption_set":       dataSourceDiskEncryptionSet(),
+		"azurerm_disk_restore_points":       dataSourceDiskRestorePoints(),
 		"azurerm_managed_disk":              dataSourceManagedDisk(),
 		"azurerm_image":                     dataSourceImage(),
 		"azurerm_images":                    dataSourceImages(),
 		"azurerm_disk_access":               dataSourceDiskAccess(),
 		"azurerm_platform_image":            dataSourcePlatformImage(),
+		"azurerm_platform_images":           dataSourcePlatformImages(),
 		"azurerm_proximity_placement_group": dataSourceProximityPlacementGroup(),
 		"azurerm_shared_image_gallery":      dataSourceSharedImageGallery(),
 		"azurerm_shared_image_version":      dataSourceSharedImageVersion(),
@@ -45,30 +47,34 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	resources := map[string]*pluginsdk.Resource{
-		"azurerm_availability_set":                       resourceAvailabilitySet(),
-		"azurerm_dedicated_host":                         resourceDedicatedHost(),
-		"azurerm_dedicated_host_group":                   resourceDedicatedHostGroup(),
-		"azurerm_disk_encryption_set":                    resourceDiskEncryptionSet(),
-		"azurerm_image":                                  resourceImage(),
-		"azurerm_managed_disk":                           resourceManagedDisk(),
-		"azurerm_disk_access":                            resourceDiskAccess(),
-		"azurerm_marketplace_agreement":                  resourceMarketplaceAgreement(),
-		"azurerm_proximity_placement_group":              resourceProximityPlacementGroup(),
-		"azurerm_shared_image_gallery":                   resourceSharedImageGallery(),
-		"azurerm_shared_image_version":                   resourceSharedImageVersion(),
-		"azurerm_shared_image":                           resourceSharedImage(),
-		"azurerm_snapshot":                               resourceSnapshot(),
-		"azurerm_virtual_machine_data_disk_attachment":   resourceVirtualMachineDataDiskAttachment(),
-		"azurerm_virtual_machine_extension":              resourceVirtualMachineExtension(),
-		"azurerm_virtual_machine_scale_set":              resourceVirtualMachineScaleSet(),
-		"azurerm_orchestrated_virtual_machine_scale_set": resourceOrchestratedVirtualMachineScaleSet(),
-		"azurerm_virtual_machine":                        resourceVirtualMachine(),
-		"azurerm_linux_virtual_machine":                  resourceLinuxVirtualMachine(),
-		"azurerm_linux_virtual_machine_scale_set":        resourceLinuxVirtualMachineScaleSet(),
-		"azurerm_virtual_machine_scale_set_extension":    resourceVirtualMachineScaleSetExtension(),
-		"azurerm_windows_virtual_machine":                resourceWindowsVirtualMachine(),
-		"azurerm_windows_virtual_machine_scale_set":      resourceWindowsVirtualMachineScaleSet(),
-		"azurerm_ssh_public_key":                         resourceSshPublicKey(),
+		"azurerm_availability_set":                              resourceAvailabilitySet(),
+		"azurerm_dedicated_host":                                resourceDedicatedHost(),
+		"azurerm_dedicated_host_group":                          resourceDedicatedHostGroup(),
+		"azurerm_disk_encryption_set":                           resourceDiskEncryptionSet(),
+		"azurerm_image":                                         resourceImage(),
+		"azurerm_managed_disk":                                  resourceManagedDisk(),
+		"azurerm_managed_disk_sas_token":                        resourceManagedDiskSasToken(),
+		"azurerm_disk_access":                                   resourceDiskAccess(),
+		"azurerm_marketplace_agreement":                         resourceMarketplaceAgreement(),
+		"azurerm_proximity_placement_group":                     resourceProximityPlacementGroup(),
+		"azurerm_restore_point_collection":                      resourceRestorePointCollection(),
+		"azurerm_shared_image_gallery":                          resourceSharedImageGallery(),
+		"azurerm_shared_image_version":                          resourceSharedImageVersion(),
+		"azurerm_shared_image":                                  resourceSharedImage(),
+		"azurerm_snapshot":                                      resourceSnapshot(),
+		"azurerm_virtual_machine_data_disk_attachment":          resourceVirtualMachineDataDiskAttachment(),
+		"azurerm_virtual_machine_extension":                     resourceVirtualMachineExtension(),
+		"azurerm_virtual_machine_restore_point":                 resourceVirtualMachineRestorePoint(),
+		"azurerm_virtual_machine_scale_set":                     resourceVirtualMachineScaleSet(),
+		"azurerm_orchestrated_virtual_machine_scale_set":        resourceOrchestratedVirtualMachineScaleSet(),
+		"azurerm_virtual_machine":                               resourceVirtualMachine(),
+		"azurerm_linux_virtual_machine":                         resourceLinuxVirtualMachine(),
+		"azurerm_linux_virtual_machine_scale_set":               resourceLinuxVirtualMachineScaleSet(),
+		"azurerm_virtual_machine_scale_set_extension":           resourceVirtualMachineScaleSetExtension(),
+		"azurerm_virtual_machine_scale_set_instance_protection": resourceVirtualMachineScaleSetInstanceProtection(),
+		"azurerm_windows_virtual_machine":                       resourceWindowsVirtualMachine(),
+		"azurerm_windows_virtual_machine_scale_set":             resourceWindowsVirtualMachineScaleSet(),
+		"azurerm_ssh_public_key":                                resourceSshPublicKey(),
 	}
 
 	return resources
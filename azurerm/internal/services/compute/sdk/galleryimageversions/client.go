@@ -0,0 +1,15 @@
+package galleryimageversions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GalleryImageVersionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGalleryImageVersionsClientWithBaseURI(endpoint string) GalleryImageVersionsClient {
+	return GalleryImageVersionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
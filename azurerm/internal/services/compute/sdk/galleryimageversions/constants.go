@@ -0,0 +1,59 @@
+package galleryimageversions
+
+type AggregatedReplicationState string
+
+const (
+	AggregatedReplicationStateCompleted  AggregatedReplicationState = "Completed"
+	AggregatedReplicationStateFailed     AggregatedReplicationState = "Failed"
+	AggregatedReplicationStateInProgress AggregatedReplicationState = "InProgress"
+	AggregatedReplicationStateUnknown    AggregatedReplicationState = "Unknown"
+)
+
+type GalleryProvisioningState string
+
+const (
+	GalleryProvisioningStateCreating  GalleryProvisioningState = "Creating"
+	GalleryProvisioningStateDeleting  GalleryProvisioningState = "Deleting"
+	GalleryProvisioningStateFailed    GalleryProvisioningState = "Failed"
+	GalleryProvisioningStateMigrating GalleryProvisioningState = "Migrating"
+	GalleryProvisioningStateSucceeded GalleryProvisioningState = "Succeeded"
+	GalleryProvisioningStateUpdating  GalleryProvisioningState = "Updating"
+)
+
+type HostCaching string
+
+const (
+	HostCachingNone      HostCaching = "None"
+	HostCachingReadOnly  HostCaching = "ReadOnly"
+	HostCachingReadWrite HostCaching = "ReadWrite"
+)
+
+type ReplicationMode string
+
+const (
+	ReplicationModeFull    ReplicationMode = "Full"
+	ReplicationModeShallow ReplicationMode = "Shallow"
+)
+
+type ReplicationState string
+
+const (
+	ReplicationStateCompleted   ReplicationState = "Completed"
+	ReplicationStateFailed      ReplicationState = "Failed"
+	ReplicationStateReplicating ReplicationState = "Replicating"
+	ReplicationStateUnknown     ReplicationState = "Unknown"
+)
+
+type ReplicationStatusTypes string
+
+const (
+	ReplicationStatusTypesReplicationStatus ReplicationStatusTypes = "ReplicationStatus"
+)
+
+type StorageAccountType string
+
+const (
+	StorageAccountTypePremiumLRS  StorageAccountType = "Premium_LRS"
+	StorageAccountTypeStandardLRS StorageAccountType = "Standard_LRS"
+	StorageAccountTypeStandardZRS StorageAccountType = "Standard_ZRS"
+)
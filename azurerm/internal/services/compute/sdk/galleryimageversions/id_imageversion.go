@@ -0,0 +1,144 @@
+package galleryimageversions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ImageVersionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GalleryName    string
+	ImageName      string
+	VersionName    string
+}
+
+func NewImageVersionID(subscriptionId, resourceGroup, galleryName, imageName, versionName string) ImageVersionId {
+	return ImageVersionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GalleryName:    galleryName,
+		ImageName:      imageName,
+		VersionName:    versionName,
+	}
+}
+
+func (id ImageVersionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Version Name %q", id.VersionName),
+		fmt.Sprintf("Image Name %q", id.ImageName),
+		fmt.Sprintf("Gallery Name %q", id.GalleryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Image Version", segmentsStr)
+}
+
+func (id ImageVersionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/galleries/%s/images/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName)
+}
+
+// ImageVersionID parses a ImageVersion ID into an ImageVersionId struct
+func ImageVersionID(input string) (*ImageVersionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ImageVersionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+		return nil, err
+	}
+	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ImageVersionIDInsensitively parses an ImageVersion ID into an ImageVersionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ImageVersionID method should be used instead for validation etc.
+func ImageVersionIDInsensitively(input string) (*ImageVersionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ImageVersionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'galleries' segment
+	galleriesKey := "galleries"
+	for key := range id.Path {
+		if strings.EqualFold(key, galleriesKey) {
+			galleriesKey = key
+			break
+		}
+	}
+	if resourceId.GalleryName, err = id.PopSegment(galleriesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'images' segment
+	imagesKey := "images"
+	for key := range id.Path {
+		if strings.EqualFold(key, imagesKey) {
+			imagesKey = key
+			break
+		}
+	}
+	if resourceId.ImageName, err = id.PopSegment(imagesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'versions' segment
+	versionsKey := "versions"
+	for key := range id.Path {
+		if strings.EqualFold(key, versionsKey) {
+			versionsKey = key
+			break
+		}
+	}
+	if resourceId.VersionName, err = id.PopSegment(versionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
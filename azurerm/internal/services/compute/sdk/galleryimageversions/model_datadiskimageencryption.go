@@ -0,0 +1,6 @@
+package galleryimageversions
+
+type DataDiskImageEncryption struct {
+	DiskEncryptionSetId *string `json:"diskEncryptionSetId,omitempty"`
+	Lun                 int64   `json:"lun"`
+}
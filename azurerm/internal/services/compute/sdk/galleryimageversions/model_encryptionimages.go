@@ -0,0 +1,6 @@
+package galleryimageversions
+
+type EncryptionImages struct {
+	DataDiskImages *[]DataDiskImageEncryption `json:"dataDiskImages,omitempty"`
+	OsDiskImage    *OSDiskImageEncryption     `json:"osDiskImage,omitempty"`
+}
@@ -0,0 +1,6 @@
+package galleryimageversions
+
+type GalleryArtifactVersionSource struct {
+	Id  *string `json:"id,omitempty"`
+	Uri *string `json:"uri,omitempty"`
+}
@@ -0,0 +1,8 @@
+package galleryimageversions
+
+type GalleryDataDiskImage struct {
+	HostCaching *HostCaching                  `json:"hostCaching,omitempty"`
+	Lun         int64                         `json:"lun"`
+	SizeInGB    *int64                        `json:"sizeInGB,omitempty"`
+	Source      *GalleryArtifactVersionSource `json:"source,omitempty"`
+}
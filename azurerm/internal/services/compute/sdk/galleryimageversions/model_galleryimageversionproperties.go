@@ -0,0 +1,8 @@
+package galleryimageversions
+
+type GalleryImageVersionProperties struct {
+	ProvisioningState *GalleryProvisioningState             `json:"provisioningState,omitempty"`
+	PublishingProfile *GalleryImageVersionPublishingProfile `json:"publishingProfile,omitempty"`
+	ReplicationStatus *ReplicationStatus                    `json:"replicationStatus,omitempty"`
+	StorageProfile    GalleryImageVersionStorageProfile     `json:"storageProfile"`
+}
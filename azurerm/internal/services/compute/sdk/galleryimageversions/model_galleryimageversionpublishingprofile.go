@@ -0,0 +1,35 @@
+package galleryimageversions
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/formatting"
+)
+
+type GalleryImageVersionPublishingProfile struct {
+	EndOfLifeDate      *string             `json:"endOfLifeDate,omitempty"`
+	ExcludeFromLatest  *bool               `json:"excludeFromLatest,omitempty"`
+	PublishedDate      *string             `json:"publishedDate,omitempty"`
+	ReplicaCount       *int64              `json:"replicaCount,omitempty"`
+	ReplicationMode    *ReplicationMode    `json:"replicationMode,omitempty"`
+	StorageAccountType *StorageAccountType `json:"storageAccountType,omitempty"`
+	TargetRegions      *[]TargetRegion     `json:"targetRegions,omitempty"`
+}
+
+func (o GalleryImageVersionPublishingProfile) ListEndOfLifeDateAsTime() (*time.Time, error) {
+	return formatting.ParseAsDateFormat(o.EndOfLifeDate, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o GalleryImageVersionPublishingProfile) SetEndOfLifeDateAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.EndOfLifeDate = &formatted
+}
+
+func (o GalleryImageVersionPublishingProfile) ListPublishedDateAsTime() (*time.Time, error) {
+	return formatting.ParseAsDateFormat(o.PublishedDate, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o GalleryImageVersionPublishingProfile) SetPublishedDateAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.PublishedDate = &formatted
+}
@@ -0,0 +1,7 @@
+package galleryimageversions
+
+type GalleryImageVersionStorageProfile struct {
+	DataDiskImages *[]GalleryDataDiskImage       `json:"dataDiskImages,omitempty"`
+	OsDiskImage    *GalleryOSDiskImage           `json:"osDiskImage,omitempty"`
+	Source         *GalleryArtifactVersionSource `json:"source,omitempty"`
+}
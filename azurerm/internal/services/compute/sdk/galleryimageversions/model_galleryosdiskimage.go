@@ -0,0 +1,7 @@
+package galleryimageversions
+
+type GalleryOSDiskImage struct {
+	HostCaching *HostCaching                  `json:"hostCaching,omitempty"`
+	SizeInGB    *int64                        `json:"sizeInGB,omitempty"`
+	Source      *GalleryArtifactVersionSource `json:"source,omitempty"`
+}
@@ -0,0 +1,5 @@
+package galleryimageversions
+
+type OSDiskImageEncryption struct {
+	DiskEncryptionSetId *string `json:"diskEncryptionSetId,omitempty"`
+}
@@ -0,0 +1,8 @@
+package galleryimageversions
+
+type RegionalReplicationStatus struct {
+	Details  *string           `json:"details,omitempty"`
+	Progress *int64            `json:"progress,omitempty"`
+	Region   *string           `json:"region,omitempty"`
+	State    *ReplicationState `json:"state,omitempty"`
+}
@@ -0,0 +1,6 @@
+package galleryimageversions
+
+type ReplicationStatus struct {
+	AggregatedState *AggregatedReplicationState  `json:"aggregatedState,omitempty"`
+	Summary         *[]RegionalReplicationStatus `json:"summary,omitempty"`
+}
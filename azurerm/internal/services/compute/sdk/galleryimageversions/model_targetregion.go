@@ -0,0 +1,8 @@
+package galleryimageversions
+
+type TargetRegion struct {
+	Encryption           *EncryptionImages   `json:"encryption,omitempty"`
+	Name                 string              `json:"name"`
+	RegionalReplicaCount *int64              `json:"regionalReplicaCount,omitempty"`
+	StorageAccountType   *StorageAccountType `json:"storageAccountType,omitempty"`
+}
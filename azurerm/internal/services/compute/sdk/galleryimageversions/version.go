@@ -0,0 +1,9 @@
+package galleryimageversions
+
+import "fmt"
+
+const defaultApiVersion = "2021-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/galleryimageversions/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package restorepointcollections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RestorePointCollectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRestorePointCollectionsClientWithBaseURI(endpoint string) RestorePointCollectionsClient {
+	return RestorePointCollectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,108 @@
+package restorepointcollections
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type RestorePointCollectionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewRestorePointCollectionID(subscriptionId, resourceGroup, name string) RestorePointCollectionId {
+	return RestorePointCollectionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id RestorePointCollectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Restore Point Collection", segmentsStr)
+}
+
+func (id RestorePointCollectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/restorePointCollections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// RestorePointCollectionID parses a RestorePointCollection ID into an RestorePointCollectionId struct
+func RestorePointCollectionID(input string) (*RestorePointCollectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RestorePointCollectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("restorePointCollections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// RestorePointCollectionIDInsensitively parses an RestorePointCollection ID into an RestorePointCollectionId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the RestorePointCollectionID method should be used instead for validation etc.
+func RestorePointCollectionIDInsensitively(input string) (*RestorePointCollectionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RestorePointCollectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'restorePointCollections' segment
+	restorePointCollectionsKey := "restorePointCollections"
+	for key := range id.Path {
+		if strings.EqualFold(key, restorePointCollectionsKey) {
+			restorePointCollectionsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(restorePointCollectionsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,10 @@
+package restorepointcollections
+
+type RestorePointCollection struct {
+	Id         *string                           `json:"id,omitempty"`
+	Location   string                            `json:"location"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *RestorePointCollectionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package restorepointcollections
+
+type RestorePointCollectionProperties struct {
+	ProvisioningState        *string                                 `json:"provisioningState,omitempty"`
+	RestorePointCollectionId *string                                 `json:"restorePointCollectionId,omitempty"`
+	Source                   *RestorePointCollectionSourceProperties `json:"source,omitempty"`
+}
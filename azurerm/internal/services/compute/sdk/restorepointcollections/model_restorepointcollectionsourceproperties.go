@@ -0,0 +1,6 @@
+package restorepointcollections
+
+type RestorePointCollectionSourceProperties struct {
+	Id       *string `json:"id,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
@@ -0,0 +1,9 @@
+package restorepointcollections
+
+import "fmt"
+
+const defaultApiVersion = "2021-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/restorepointcollections/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package restorepoints
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RestorePointsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRestorePointsClientWithBaseURI(endpoint string) RestorePointsClient {
+	return RestorePointsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
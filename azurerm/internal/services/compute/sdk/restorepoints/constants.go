@@ -0,0 +1,9 @@
+package restorepoints
+
+type ConsistencyModeTypes string
+
+const (
+	ConsistencyModeTypesApplicationConsistent ConsistencyModeTypes = "ApplicationConsistent"
+	ConsistencyModeTypesCrashConsistent       ConsistencyModeTypes = "CrashConsistent"
+	ConsistencyModeTypesFileSystemConsistent  ConsistencyModeTypes = "FileSystemConsistent"
+)
@@ -0,0 +1,8 @@
+package restorepoints
+
+type RestorePoint struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *RestorePointProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
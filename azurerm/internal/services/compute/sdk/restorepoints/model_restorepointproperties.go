@@ -0,0 +1,7 @@
+package restorepoints
+
+type RestorePointProperties struct {
+	ConsistencyMode   *ConsistencyModeTypes `json:"consistencyMode,omitempty"`
+	ExcludeDisks      *[]ApiEntityReference `json:"excludeDisks,omitempty"`
+	ProvisioningState *string               `json:"provisioningState,omitempty"`
+}
@@ -4,17 +4,20 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/galleryimageversions"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -28,7 +31,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 		Delete: resourceSharedImageVersionDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SharedImageVersionID(id)
+			_, err := galleryimageversions.ImageVersionID(id)
 			return err
 		}),
 
@@ -90,10 +93,17 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(compute.StorageAccountTypeStandardLRS),
-								string(compute.StorageAccountTypeStandardZRS),
+								string(galleryimageversions.StorageAccountTypeStandardLRS),
+								string(galleryimageversions.StorageAccountTypeStandardZRS),
 							}, false),
-							Default: string(compute.StorageAccountTypeStandardLRS),
+							Default: string(galleryimageversions.StorageAccountTypeStandardLRS),
+						},
+
+						// the Disk Encryption Set must be in the same region as the replica, so this is configured per region
+						"disk_encryption_set_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.DiskEncryptionSetID,
 						},
 					},
 				},
@@ -103,7 +113,7 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ExactlyOneOf: []string{"os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
 				// TODO -- add a validation function when snapshot has its own validation function
 			},
 
@@ -115,7 +125,59 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 					validate.ImageID,
 					validate.VirtualMachineID,
 				),
-				ExactlyOneOf: []string{"os_disk_snapshot_id", "managed_image_id"},
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+			},
+
+			"blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithScheme([]string{"http", "https"}),
+				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
+				RequiredWith: []string{"storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+				RequiredWith: []string{"blob_uri"},
+			},
+
+			"data_disk_snapshot": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"os_disk_snapshot_id"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"lun": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 2000),
+						},
+
+						"snapshot_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+				},
+			},
+
+			"replication_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(galleryimageversions.ReplicationModeFull),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(galleryimageversions.ReplicationModeFull),
+					string(galleryimageversions.ReplicationModeShallow),
+				}, false),
 			},
 
 			"exclude_from_latest": {
@@ -124,152 +186,218 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"end_of_life_date": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
 func resourceSharedImageVersionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute.GalleryImageVersionsSDKClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	imageVersion := d.Get("name").(string)
-	imageName := d.Get("image_name").(string)
-	galleryName := d.Get("gallery_name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
+	id := galleryimageversions.NewImageVersionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("gallery_name").(string), d.Get("image_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, "")
+		existing, err := client.Get(ctx, id, galleryimageversions.DefaultGetOperationOptions())
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("Error checking for presence of existing Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_shared_image_version", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_shared_image_version", id.ID())
 		}
 	}
 
-	version := compute.GalleryImageVersion{
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
-			PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+	dataDiskSnapshots := d.Get("data_disk_snapshot").([]interface{})
+	if err := validateSharedImageVersionDataDiskSnapshots(dataDiskSnapshots); err != nil {
+		return err
+	}
+
+	replicationMode := galleryimageversions.ReplicationMode(d.Get("replication_mode").(string))
+	version := galleryimageversions.GalleryImageVersion{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &galleryimageversions.GalleryImageVersionProperties{
+			PublishingProfile: &galleryimageversions.GalleryImageVersionPublishingProfile{
 				ExcludeFromLatest: utils.Bool(d.Get("exclude_from_latest").(bool)),
-				TargetRegions:     expandSharedImageVersionTargetRegions(d),
+				ReplicationMode:   &replicationMode,
+				TargetRegions:     expandSharedImageVersionTargetRegions(d.Get("target_region").(*pluginsdk.Set).List(), dataDiskSnapshots),
 			},
-			StorageProfile: &compute.GalleryImageVersionStorageProfile{},
+			StorageProfile: galleryimageversions.GalleryImageVersionStorageProfile{},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("end_of_life_date"); ok {
+		endOfLifeDate, _ := time.Parse(time.RFC3339, v.(string))
+		version.Properties.PublishingProfile.EndOfLifeDate = utils.String(endOfLifeDate.Format(time.RFC3339))
 	}
 
 	if v, ok := d.GetOk("managed_image_id"); ok {
-		version.GalleryImageVersionProperties.StorageProfile.Source = &compute.GalleryArtifactVersionSource{
-			ID: utils.String(v.(string)),
+		version.Properties.StorageProfile.Source = &galleryimageversions.GalleryArtifactVersionSource{
+			Id: utils.String(v.(string)),
 		}
 	}
 
 	if v, ok := d.GetOk("os_disk_snapshot_id"); ok {
-		version.GalleryImageVersionProperties.StorageProfile.OsDiskImage = &compute.GalleryOSDiskImage{
-			Source: &compute.GalleryArtifactVersionSource{
-				ID: utils.String(v.(string)),
+		version.Properties.StorageProfile.OsDiskImage = &galleryimageversions.GalleryOSDiskImage{
+			Source: &galleryimageversions.GalleryArtifactVersionSource{
+				Id: utils.String(v.(string)),
 			},
 		}
+		version.Properties.StorageProfile.DataDiskImages = expandSharedImageVersionDataDiskSnapshots(dataDiskSnapshots)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, imageName, imageVersion, version)
-	if err != nil {
-		return fmt.Errorf("Error creating Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+	// when the Image Version is created from a VHD the Source is the Storage Account containing the Blob
+	if v, ok := d.GetOk("blob_uri"); ok {
+		version.Properties.StorageProfile.OsDiskImage = &galleryimageversions.GalleryOSDiskImage{
+			Source: &galleryimageversions.GalleryArtifactVersionSource{
+				Id:  utils.String(d.Get("storage_account_id").(string)),
+				Uri: utils.String(v.(string)),
+			},
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for the creation of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, version); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	read, err := client.Get(ctx, resourceGroup, galleryName, imageName, imageVersion, "")
-	if err != nil {
-		return fmt.Errorf("Error retrieving Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", imageVersion, imageName, galleryName, resourceGroup, err)
+	// when the Target Regions are changed the update completes before the new replicas are available, which
+	// means the Image Version can't yet be used in those regions - so we need to poll until replication completes
+	if !d.IsNewResource() && d.HasChange("target_region") {
+		timeout, _ := ctx.Deadline()
+		log.Printf("[DEBUG] Waiting for %s to finish replicating", id)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{string(galleryimageversions.AggregatedReplicationStateInProgress), string(galleryimageversions.AggregatedReplicationStateUnknown)},
+			Target:     []string{string(galleryimageversions.AggregatedReplicationStateCompleted)},
+			Refresh:    sharedImageVersionReplicationStateRefreshFunc(ctx, client, id),
+			MinTimeout: 30 * time.Second,
+			Timeout:    time.Until(timeout),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to finish replicating: %+v", id, err)
+		}
 	}
 
-	d.SetId(*read.ID)
+	d.SetId(id.ID())
 
 	return resourceSharedImageVersionRead(d, meta)
 }
 
 func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute.GalleryImageVersionsSDKClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SharedImageVersionID(d.Id())
+	id, err := galleryimageversions.ImageVersionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, compute.ReplicationStatusTypesReplicationStatus)
+	resp, err := client.Get(ctx, *id, sharedImageVersionReplicationStatusOptions())
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[DEBUG] Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) was not found - removing from state", id.VersionName, id.ImageName, id.GalleryName, id.ResourceGroup)
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", id.VersionName, id.ImageName, id.GalleryName, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	d.Set("name", resp.Name)
+	d.Set("name", id.VersionName)
 	d.Set("image_name", id.ImageName)
 	d.Set("gallery_name", id.GalleryName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if props := resp.GalleryImageVersionProperties; props != nil {
-		if profile := props.PublishingProfile; profile != nil {
-			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
-
-			if err := d.Set("target_region", flattenSharedImageVersionTargetRegions(profile.TargetRegions)); err != nil {
-				return fmt.Errorf("Error setting `target_region`: %+v", err)
+	if model := resp.Model; model != nil {
+		d.Set("location", azure.NormalizeLocation(model.Location))
+
+		if props := model.Properties; props != nil {
+			if profile := props.PublishingProfile; profile != nil {
+				d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+				endOfLifeDate := ""
+				if profile.EndOfLifeDate != nil {
+					t, err := profile.ListEndOfLifeDateAsTime()
+					if err != nil {
+						return fmt.Errorf("parsing `end_of_life_date`: %+v", err)
+					}
+					endOfLifeDate = t.Format(time.RFC3339)
+				}
+				d.Set("end_of_life_date", endOfLifeDate)
+
+				replicationMode := string(galleryimageversions.ReplicationModeFull)
+				if profile.ReplicationMode != nil {
+					replicationMode = string(*profile.ReplicationMode)
+				}
+				d.Set("replication_mode", replicationMode)
+
+				if err := d.Set("target_region", flattenSharedImageVersionTargetRegions(profile.TargetRegions)); err != nil {
+					return fmt.Errorf("setting `target_region`: %+v", err)
+				}
 			}
-		}
 
-		if profile := props.StorageProfile; profile != nil {
+			profile := props.StorageProfile
 			if source := profile.Source; source != nil {
-				d.Set("managed_image_id", source.ID)
+				d.Set("managed_image_id", source.Id)
 			}
 
+			blobUri := ""
+			storageAccountId := ""
 			osDiskSnapShotID := ""
-			if profile.OsDiskImage != nil && profile.OsDiskImage.Source != nil && profile.OsDiskImage.Source.ID != nil {
-				osDiskSnapShotID = *profile.OsDiskImage.Source.ID
+			if profile.OsDiskImage != nil && profile.OsDiskImage.Source != nil {
+				source := profile.OsDiskImage.Source
+				if source.Uri != nil && *source.Uri != "" {
+					blobUri = *source.Uri
+					if source.Id != nil {
+						storageAccountId = *source.Id
+					}
+				} else if source.Id != nil {
+					osDiskSnapShotID = *source.Id
+				}
 			}
+			d.Set("blob_uri", blobUri)
+			d.Set("storage_account_id", storageAccountId)
 			d.Set("os_disk_snapshot_id", osDiskSnapShotID)
+
+			if err := d.Set("data_disk_snapshot", flattenSharedImageVersionDataDiskSnapshots(profile.DataDiskImages)); err != nil {
+				return fmt.Errorf("setting `data_disk_snapshot`: %+v", err)
+			}
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.GalleryImageVersionsClient
+	client := meta.(*clients.Client).Compute.GalleryImageVersionsSDKClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.SharedImageVersionID(d.Id())
+	id, err := galleryimageversions.ImageVersionID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
-
 	// @tombuildsstuff: there appears to be an eventual consistency issue here
 	timeout, _ := ctx.Deadline()
 	log.Printf("[DEBUG] Waiting for %s to be eventually deleted", *id)
@@ -289,14 +417,21 @@ func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
-func sharedImageVersionDeleteStateRefreshFunc(ctx context.Context, client *compute.GalleryImageVersionsClient, id parse.SharedImageVersionId) pluginsdk.StateRefreshFunc {
+func sharedImageVersionReplicationStatusOptions() galleryimageversions.GetOperationOptions {
+	expand := galleryimageversions.ReplicationStatusTypesReplicationStatus
+	return galleryimageversions.GetOperationOptions{
+		Expand: &expand,
+	}
+}
+
+func sharedImageVersionDeleteStateRefreshFunc(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId) pluginsdk.StateRefreshFunc {
 	// Whilst the Shared Image Version is deleted quickly, it appears it's not actually finished replicating at this time
 	// so the deletion of the parent Shared Image fails with "can not delete until nested resources are deleted"
 	// ergo we need to poll on this for a bit
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName, "")
+		res, err := client.Get(ctx, id, galleryimageversions.DefaultGetOperationOptions())
 		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
+			if response.WasNotFound(res.HttpResponse) {
 				return "NotFound", "NotFound", nil
 			}
 
@@ -307,44 +442,163 @@ func sharedImageVersionDeleteStateRefreshFunc(ctx context.Context, client *compu
 	}
 }
 
-func expandSharedImageVersionTargetRegions(d *pluginsdk.ResourceData) *[]compute.TargetRegion {
-	vs := d.Get("target_region").(*pluginsdk.Set)
-	results := make([]compute.TargetRegion, 0)
+func sharedImageVersionReplicationStateRefreshFunc(ctx context.Context, client *galleryimageversions.GalleryImageVersionsClient, id galleryimageversions.ImageVersionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id, sharedImageVersionReplicationStatusOptions())
+		if err != nil {
+			return nil, "", fmt.Errorf("polling the Replication Status of %s: %+v", id, err)
+		}
+
+		if res.Model == nil || res.Model.Properties == nil || res.Model.Properties.ReplicationStatus == nil || res.Model.Properties.ReplicationStatus.AggregatedState == nil {
+			return res, string(galleryimageversions.AggregatedReplicationStateUnknown), nil
+		}
 
-	for _, v := range vs.List() {
-		input := v.(map[string]interface{})
+		status := res.Model.Properties.ReplicationStatus
+		if *status.AggregatedState == galleryimageversions.AggregatedReplicationStateFailed {
+			failures := make([]string, 0)
+			if status.Summary != nil {
+				for _, region := range *status.Summary {
+					if region.State == nil || *region.State != galleryimageversions.ReplicationStateFailed || region.Region == nil {
+						continue
+					}
+
+					details := ""
+					if region.Details != nil {
+						details = *region.Details
+					}
+					failures = append(failures, fmt.Sprintf("%s: %s", *region.Region, details))
+				}
+			}
 
-		name := input["name"].(string)
-		regionalReplicaCount := input["regional_replica_count"].(int)
-		storageAccountType := input["storage_account_type"].(string)
+			return res, string(*status.AggregatedState), fmt.Errorf("replication failed: %s", strings.Join(failures, ", "))
+		}
+
+		return res, string(*status.AggregatedState), nil
+	}
+}
 
-		output := compute.TargetRegion{
-			Name:                 utils.String(name),
-			RegionalReplicaCount: utils.Int32(int32(regionalReplicaCount)),
-			StorageAccountType:   compute.StorageAccountType(storageAccountType),
+func validateSharedImageVersionDataDiskSnapshots(input []interface{}) error {
+	luns := make(map[int]struct{})
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		lun := raw["lun"].(int)
+		if _, exists := luns[lun]; exists {
+			return fmt.Errorf("each `data_disk_snapshot` must have a unique `lun` but %d was specified multiple times", lun)
 		}
+		luns[lun] = struct{}{}
+	}
+
+	return nil
+}
+
+func expandSharedImageVersionDataDiskSnapshots(input []interface{}) *[]galleryimageversions.GalleryDataDiskImage {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]galleryimageversions.GalleryDataDiskImage, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		results = append(results, galleryimageversions.GalleryDataDiskImage{
+			Lun: int64(raw["lun"].(int)),
+			Source: &galleryimageversions.GalleryArtifactVersionSource{
+				Id: utils.String(raw["snapshot_id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenSharedImageVersionDataDiskSnapshots(input *[]galleryimageversions.GalleryDataDiskImage) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		// when the Image Version is created from a Managed Image the Data Disks are returned without a Source
+		if v.Source == nil || v.Source.Id == nil {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"lun":         int(v.Lun),
+			"snapshot_id": *v.Source.Id,
+		})
+	}
+
+	return results
+}
+
+func expandSharedImageVersionTargetRegions(input []interface{}, dataDiskSnapshots []interface{}) *[]galleryimageversions.TargetRegion {
+	results := make([]galleryimageversions.TargetRegion, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		name := raw["name"].(string)
+		regionalReplicaCount := raw["regional_replica_count"].(int)
+		storageAccountType := galleryimageversions.StorageAccountType(raw["storage_account_type"].(string))
+
+		output := galleryimageversions.TargetRegion{
+			Name:                 name,
+			RegionalReplicaCount: utils.Int64(int64(regionalReplicaCount)),
+			StorageAccountType:   &storageAccountType,
+		}
+
+		if diskEncryptionSetId := raw["disk_encryption_set_id"].(string); diskEncryptionSetId != "" {
+			encryption := galleryimageversions.EncryptionImages{
+				OsDiskImage: &galleryimageversions.OSDiskImageEncryption{
+					DiskEncryptionSetId: utils.String(diskEncryptionSetId),
+				},
+			}
+
+			if len(dataDiskSnapshots) > 0 {
+				dataDiskImages := make([]galleryimageversions.DataDiskImageEncryption, 0)
+				for _, dataDisk := range dataDiskSnapshots {
+					dataDiskRaw := dataDisk.(map[string]interface{})
+					dataDiskImages = append(dataDiskImages, galleryimageversions.DataDiskImageEncryption{
+						Lun:                 int64(dataDiskRaw["lun"].(int)),
+						DiskEncryptionSetId: utils.String(diskEncryptionSetId),
+					})
+				}
+				encryption.DataDiskImages = &dataDiskImages
+			}
+
+			output.Encryption = &encryption
+		}
+
 		results = append(results, output)
 	}
 
 	return &results
 }
 
-func flattenSharedImageVersionTargetRegions(input *[]compute.TargetRegion) []interface{} {
+func flattenSharedImageVersionTargetRegions(input *[]galleryimageversions.TargetRegion) []interface{} {
 	results := make([]interface{}, 0)
 
 	if input != nil {
 		for _, v := range *input {
 			output := make(map[string]interface{})
 
-			if v.Name != nil {
-				output["name"] = azure.NormalizeLocation(*v.Name)
-			}
+			output["name"] = azure.NormalizeLocation(v.Name)
 
 			if v.RegionalReplicaCount != nil {
 				output["regional_replica_count"] = int(*v.RegionalReplicaCount)
 			}
 
-			output["storage_account_type"] = string(v.StorageAccountType)
+			storageAccountType := ""
+			if v.StorageAccountType != nil {
+				storageAccountType = string(*v.StorageAccountType)
+			}
+			output["storage_account_type"] = storageAccountType
+
+			diskEncryptionSetId := ""
+			if v.Encryption != nil && v.Encryption.OsDiskImage != nil && v.Encryption.OsDiskImage.DiskEncryptionSetId != nil {
+				diskEncryptionSetId = *v.Encryption.OsDiskImage.DiskEncryptionSetId
+			}
+			output["disk_encryption_set_id"] = diskEncryptionSetId
 
 			results = append(results, output)
 		}
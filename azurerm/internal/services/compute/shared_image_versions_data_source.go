@@ -2,6 +2,8 @@ package compute
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
@@ -10,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -39,6 +42,30 @@ func dataSourceSharedImageVersions() *pluginsdk.Resource {
 
 			"tags_filter": tags.Schema(),
 
+			"version_regex": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+
+			"include_excluded_from_latest": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"sort_versions_by_semver": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"max_versions": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"images": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -118,7 +145,32 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	flattenedImages := flattenSharedImageVersions(images, filterTags)
+	filter := sharedImageVersionsFilter{
+		tags:                      filterTags,
+		includeExcludedFromLatest: d.Get("include_excluded_from_latest").(bool),
+	}
+	// this has been validated at plan time, so can't fail to compile
+	if v := d.Get("version_regex").(string); v != "" {
+		filter.version = regexp.MustCompile(v)
+	}
+	images = filterSharedImageVersions(images, filter)
+
+	// the newest Versions are returned when the number of Versions is limited, so they need to be sorted first
+	maxVersions := d.Get("max_versions").(int)
+	if d.Get("sort_versions_by_semver").(bool) || maxVersions > 0 {
+		sort.SliceStable(images, func(i, j int) bool {
+			if images[i].Name == nil || images[j].Name == nil {
+				return images[j].Name == nil && images[i].Name != nil
+			}
+			return compareImageVersions(*images[i].Name, *images[j].Name) > 0
+		})
+	}
+
+	if maxVersions > 0 && len(images) > maxVersions {
+		images = images[:maxVersions]
+	}
+
+	flattenedImages := flattenSharedImageVersions(images)
 	if len(flattenedImages) == 0 {
 		return fmt.Errorf("unable to find any images")
 	}
@@ -136,14 +188,19 @@ func dataSourceSharedImageVersionsRead(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
-func flattenSharedImageVersions(input []compute.GalleryImageVersion, filterTags map[string]*string) []interface{} {
-	results := make([]interface{}, 0)
+type sharedImageVersionsFilter struct {
+	tags                      map[string]*string
+	version                   *regexp.Regexp
+	includeExcludedFromLatest bool
+}
+
+func filterSharedImageVersions(input []compute.GalleryImageVersion, filter sharedImageVersionsFilter) []compute.GalleryImageVersion {
+	results := make([]compute.GalleryImageVersion, 0)
 
 	for _, imageVersion := range input {
-		flattenedIPAddress := flattenSharedImageVersion(imageVersion)
 		found := true
 		// Loop through our filter tags and see if they match
-		for k, v := range filterTags {
+		for k, v := range filter.tags {
 			if v != nil {
 				// If the tags don't match, return false
 				if imageVersion.Tags[k] == nil || *v != *imageVersion.Tags[k] {
@@ -152,14 +209,36 @@ func flattenSharedImageVersions(input []compute.GalleryImageVersion, filterTags
 			}
 		}
 
+		if filter.version != nil && (imageVersion.Name == nil || !filter.version.MatchString(*imageVersion.Name)) {
+			found = false
+		}
+
+		if !filter.includeExcludedFromLatest {
+			if props := imageVersion.GalleryImageVersionProperties; props != nil && props.PublishingProfile != nil {
+				if excluded := props.PublishingProfile.ExcludeFromLatest; excluded != nil && *excluded {
+					found = false
+				}
+			}
+		}
+
 		if found {
-			results = append(results, flattenedIPAddress)
+			results = append(results, imageVersion)
 		}
 	}
 
 	return results
 }
 
+func flattenSharedImageVersions(input []compute.GalleryImageVersion) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, imageVersion := range input {
+		results = append(results, flattenSharedImageVersion(imageVersion))
+	}
+
+	return results
+}
+
 func flattenSharedImageVersion(input compute.GalleryImageVersion) map[string]interface{} {
 	output := make(map[string]interface{})
 
@@ -80,6 +80,37 @@ func TestAccDataSourceSharedImageVersions_tagsFilter(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceSharedImageVersions_versionFilters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_shared_image_versions", "test")
+	r := SharedImageVersionsDataSource{}
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// need to create a vm and then reference it in the image creation
+			Config:  SharedImageVersionResource{}.setup(data),
+			Destroy: false,
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientForResource(ImageResource{}.virtualMachineExists, "azurerm_virtual_machine.testsource"),
+				data.CheckWithClientForResource(ImageResource{}.generalizeVirtualMachine(data), "azurerm_virtual_machine.testsource"),
+			),
+		},
+		{
+			Config: r.versionFilters(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("1"),
+				check.That(data.ResourceName).Key("images.0.name").HasValue("0.0.10"),
+			),
+		},
+		{
+			// the newest Versions should be returned when limited, regardless of the sort
+			Config: r.versionFilters(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("images.#").HasValue("1"),
+				check.That(data.ResourceName).Key("images.0.name").HasValue("0.0.10"),
+			),
+		},
+	})
+}
+
 func (SharedImageVersionsDataSource) basic(data acceptance.TestData) string {
 	template := SharedImageVersionResource{}.imageVersion(data)
 	return fmt.Sprintf(`
@@ -126,3 +157,68 @@ data "azurerm_shared_image_versions" "test" {
 }
 `, SharedImageVersionResource{}.imageVersion(data))
 }
+
+func (SharedImageVersionsDataSource) versionFilters(data acceptance.TestData, sortVersionsBySemver bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "second" {
+  name                = "0.0.9"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+
+resource "azurerm_shared_image_version" "third" {
+  name                = "0.0.10"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+
+resource "azurerm_shared_image_version" "excluded" {
+  name                = "0.0.11"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  image_name          = azurerm_shared_image.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  managed_image_id    = azurerm_image.test.id
+  exclude_from_latest = true
+
+  target_region {
+    name                   = azurerm_resource_group.test.location
+    regional_replica_count = 1
+  }
+}
+
+data "azurerm_shared_image_versions" "test" {
+  gallery_name                 = azurerm_shared_image_version.test.gallery_name
+  image_name                   = azurerm_shared_image_version.test.image_name
+  resource_group_name          = azurerm_shared_image_version.test.resource_group_name
+  version_regex                = "^0\\.0\\."
+  include_excluded_from_latest = false
+  sort_versions_by_semver      = %t
+  max_versions                 = 1
+
+  depends_on = [
+    azurerm_shared_image_version.second,
+    azurerm_shared_image_version.third,
+    azurerm_shared_image_version.excluded,
+  ]
+}
+`, SharedImageVersionResource{}.imageVersion(data), sortVersionsBySemver)
+}
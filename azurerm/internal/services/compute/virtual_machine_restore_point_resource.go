@@ -0,0 +1,194 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/restorepointcollections"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/sdk/restorepoints"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualMachineRestorePoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineRestorePointCreate,
+		Read:   resourceVirtualMachineRestorePointRead,
+		Delete: resourceVirtualMachineRestorePointDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := restorepoints.RestorePointID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"restore_point_collection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RestorePointCollectionID,
+			},
+
+			"excluded_disk_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.ManagedDiskID,
+				},
+			},
+
+			"consistency_mode": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineRestorePointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.RestorePointsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	collectionId, err := restorepointcollections.RestorePointCollectionID(d.Get("restore_point_collection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := restorepoints.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_virtual_machine_restore_point", id.ID())
+	}
+
+	parameters := restorepoints.RestorePoint{
+		Properties: &restorepoints.RestorePointProperties{
+			ExcludeDisks: expandVirtualMachineRestorePointExcludedDisks(d.Get("excluded_disk_ids").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineRestorePointRead(d, meta)
+}
+
+func resourceVirtualMachineRestorePointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.RestorePointsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := restorepoints.RestorePointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("restore_point_collection_id", restorepointcollections.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroup, id.RestorePointCollectionName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("excluded_disk_ids", flattenVirtualMachineRestorePointExcludedDisks(props.ExcludeDisks)); err != nil {
+				return fmt.Errorf("setting `excluded_disk_ids`: %+v", err)
+			}
+
+			consistencyMode := ""
+			if props.ConsistencyMode != nil {
+				consistencyMode = string(*props.ConsistencyMode)
+			}
+			d.Set("consistency_mode", consistencyMode)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualMachineRestorePointDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.RestorePointsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := restorepoints.RestorePointID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineRestorePointExcludedDisks(input []interface{}) *[]restorepoints.ApiEntityReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]restorepoints.ApiEntityReference, 0)
+	for _, v := range input {
+		results = append(results, restorepoints.ApiEntityReference{
+			Id: utils.String(v.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualMachineRestorePointExcludedDisks(input *[]restorepoints.ApiEntityReference) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id != nil {
+			results = append(results, *item.Id)
+		}
+	}
+
+	return results
+}
@@ -1,9 +1,13 @@
 package compute
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -58,6 +62,75 @@ func dataSourceVirtualMachineScaleSet() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"instances": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"instance_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"computer_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"virtual_machine_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"zone": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"latest_model_applied": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"power_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"public_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -101,5 +174,169 @@ func dataSourceVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	instances, err := retrieveVirtualMachineScaleSetInstances(ctx, meta.(*clients.Client), resGroup, name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("instances", instances); err != nil {
+		return fmt.Errorf("setting `instances`: %+v", err)
+	}
+
 	return nil
 }
+
+func retrieveVirtualMachineScaleSetInstances(ctx context.Context, client *clients.Client, resourceGroup, name string) ([]interface{}, error) {
+	vmsClient := client.Compute.VMScaleSetVMsClient
+	nicsClient := client.Network.InterfacesClient
+	pipsClient := client.Network.PublicIPsClient
+
+	// the IP Addresses of each instance are keyed by the (lower-cased) ID of the instance, which
+	// prefixes the IDs of both the Network Interfaces and the IP Configurations of each instance
+	privateIPAddresses := make(map[string][]string)
+	nics, err := nicsClient.ListVirtualMachineScaleSetNetworkInterfacesComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("listing Network Interfaces for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for nics.NotDone() {
+		nic := nics.Value()
+		if props := nic.InterfacePropertiesFormat; props != nil && props.VirtualMachine != nil && props.VirtualMachine.ID != nil && props.IPConfigurations != nil {
+			key := strings.ToLower(*props.VirtualMachine.ID)
+			for _, config := range *props.IPConfigurations {
+				if config.InterfaceIPConfigurationPropertiesFormat == nil || config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress == nil {
+					continue
+				}
+
+				address := *config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddress
+				// the Primary IP Configuration of the Primary Network Interface comes first
+				if props.Primary != nil && *props.Primary && config.Primary != nil && *config.Primary {
+					privateIPAddresses[key] = append([]string{address}, privateIPAddresses[key]...)
+				} else {
+					privateIPAddresses[key] = append(privateIPAddresses[key], address)
+				}
+			}
+		}
+
+		if err := nics.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing next page of Network Interfaces for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	publicIPAddresses := make(map[string][]string)
+	pips, err := pipsClient.ListVirtualMachineScaleSetPublicIPAddressesComplete(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("listing Public IP Addresses for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for pips.NotDone() {
+		pip := pips.Value()
+		if props := pip.PublicIPAddressPropertiesFormat; props != nil && props.IPAddress != nil && props.IPConfiguration != nil && props.IPConfiguration.ID != nil {
+			configId := strings.ToLower(*props.IPConfiguration.ID)
+			if index := strings.Index(configId, "/networkinterfaces/"); index > 0 {
+				key := configId[:index]
+				publicIPAddresses[key] = append(publicIPAddresses[key], *props.IPAddress)
+			}
+		}
+
+		if err := pips.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing next page of Public IP Addresses for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	results := make([]interface{}, 0)
+	instances, err := vmsClient.ListComplete(ctx, resourceGroup, name, "", "", string(compute.InstanceView))
+	if err != nil {
+		return nil, fmt.Errorf("listing Instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for instances.NotDone() {
+		instance := instances.Value()
+		results = append(results, flattenVirtualMachineScaleSetInstance(instance, privateIPAddresses, publicIPAddresses))
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing next page of Instances for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return results, nil
+}
+
+func flattenVirtualMachineScaleSetInstance(input compute.VirtualMachineScaleSetVM, privateIPAddresses, publicIPAddresses map[string][]string) map[string]interface{} {
+	instanceId := ""
+	if input.InstanceID != nil {
+		instanceId = *input.InstanceID
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	zone := ""
+	if input.Zones != nil && len(*input.Zones) > 0 {
+		zone = (*input.Zones)[0]
+	}
+
+	computerName := ""
+	latestModelApplied := false
+	powerState := ""
+	if props := input.VirtualMachineScaleSetVMProperties; props != nil {
+		if props.OsProfile != nil && props.OsProfile.ComputerName != nil {
+			computerName = *props.OsProfile.ComputerName
+		}
+
+		if props.LatestModelApplied != nil {
+			latestModelApplied = *props.LatestModelApplied
+		}
+
+		if instanceView := props.InstanceView; instanceView != nil && instanceView.Statuses != nil {
+			for _, status := range *instanceView.Statuses {
+				if status.Code == nil {
+					continue
+				}
+
+				// could also be the provisioning state which we're not bothered with here
+				state := strings.ToLower(*status.Code)
+				if !strings.HasPrefix(state, "powerstate/") {
+					continue
+				}
+
+				powerState = strings.TrimPrefix(state, "powerstate/")
+			}
+		}
+	}
+
+	privateIPs := privateIPAddresses[strings.ToLower(id)]
+	if privateIPs == nil {
+		privateIPs = make([]string, 0)
+	}
+	privateIP := ""
+	if len(privateIPs) > 0 {
+		privateIP = privateIPs[0]
+	}
+
+	publicIPs := publicIPAddresses[strings.ToLower(id)]
+	if publicIPs == nil {
+		publicIPs = make([]string, 0)
+	}
+	publicIP := ""
+	if len(publicIPs) > 0 {
+		publicIP = publicIPs[0]
+	}
+
+	return map[string]interface{}{
+		"instance_id":          instanceId,
+		"name":                 name,
+		"computer_name":        computerName,
+		"virtual_machine_id":   id,
+		"zone":                 zone,
+		"latest_model_applied": latestModelApplied,
+		"power_state":          powerState,
+		"private_ip_address":   privateIP,
+		"private_ip_addresses": privateIPs,
+		"public_ip_address":    publicIP,
+		"public_ip_addresses":  publicIPs,
+	}
+}
@@ -0,0 +1,231 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualMachineScaleSetInstanceProtection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineScaleSetInstanceProtectionCreate,
+		Read:   resourceVirtualMachineScaleSetInstanceProtectionRead,
+		Update: resourceVirtualMachineScaleSetInstanceProtectionUpdate,
+		Delete: resourceVirtualMachineScaleSetInstanceProtectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineScaleSetInstanceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protect_from_scale_in": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protect_from_scale_set_actions": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineScaleSetInstanceID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroup, scaleSetId.Name, d.Get("instance_id").(string))
+
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	// the protection policy always exists on an instance, so we treat any enabled protection as existing
+	if props := existing.VirtualMachineScaleSetVMProperties; props != nil && props.ProtectionPolicy != nil {
+		policy := props.ProtectionPolicy
+		if (policy.ProtectFromScaleIn != nil && *policy.ProtectFromScaleIn) || (policy.ProtectFromScaleSetActions != nil && *policy.ProtectFromScaleSetActions) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_scale_set_instance_protection", id.ID())
+		}
+	}
+
+	protectionPolicy := compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+	if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, id, existing, protectionPolicy); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineScaleSetInstanceProtectionRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("virtual_machine_scale_set_id", parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID())
+	d.Set("instance_id", id.VirtualMachineName)
+
+	protectFromScaleIn := false
+	protectFromScaleSetActions := false
+	if props := resp.VirtualMachineScaleSetVMProperties; props != nil && props.ProtectionPolicy != nil {
+		if v := props.ProtectionPolicy.ProtectFromScaleIn; v != nil {
+			protectFromScaleIn = *v
+		}
+		if v := props.ProtectionPolicy.ProtectFromScaleSetActions; v != nil {
+			protectFromScaleSetActions = *v
+		}
+	}
+	d.Set("protect_from_scale_in", protectFromScaleIn)
+	d.Set("protect_from_scale_set_actions", protectFromScaleSetActions)
+
+	return nil
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	protectionPolicy := compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+	if err := updateVirtualMachineScaleSetInstanceProtection(ctx, client, *id, existing, protectionPolicy); err != nil {
+		return err
+	}
+
+	return resourceVirtualMachineScaleSetInstanceProtectionRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetInstanceProtectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		// the instance has already gone, so there's no protection to remove
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	protectionPolicy := compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(false),
+		ProtectFromScaleSetActions: utils.Bool(false),
+	}
+	return updateVirtualMachineScaleSetInstanceProtection(ctx, client, *id, existing, protectionPolicy)
+}
+
+func updateVirtualMachineScaleSetInstanceProtection(ctx context.Context, client *compute.VirtualMachineScaleSetVMsClient, id parse.VirtualMachineScaleSetInstanceId, existing compute.VirtualMachineScaleSetVM, protectionPolicy compute.VirtualMachineScaleSetVMProtectionPolicy) error {
+	if existing.VirtualMachineScaleSetVMProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	// the instance is updated using a PUT, so we send back the existing model with the updated protection policy
+	existing.VirtualMachineScaleSetVMProperties.ProtectionPolicy = &protectionPolicy
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, existing)
+	if err != nil {
+		return fmt.Errorf("updating the Protection Policy for %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Protection Policy for %s to be updated: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualMachineScaleSetInstanceProtectionResource struct {
+}
+
+func TestAccVirtualMachineScaleSetInstanceProtection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance_protection", "test")
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protect_from_scale_in").HasValue("true"),
+				check.That(data.ResourceName).Key("protect_from_scale_set_actions").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstanceProtection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance_protection", "test")
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstanceProtection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance_protection", "test")
+	r := VirtualMachineScaleSetInstanceProtectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protect_from_scale_in").HasValue("true"),
+				check.That(data.ResourceName).Key("protect_from_scale_set_actions").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualMachineScaleSetInstanceProtectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMScaleSetVMsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.VirtualMachineScaleSetVMProperties; props != nil && props.ProtectionPolicy != nil {
+		policy := props.ProtectionPolicy
+		return utils.Bool((policy.ProtectFromScaleIn != nil && *policy.ProtectFromScaleIn) || (policy.ProtectFromScaleSetActions != nil && *policy.ProtectFromScaleSetActions)), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_instance_protection" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_id                  = data.azurerm_virtual_machine_scale_set.test.instances.0.instance_id
+  protect_from_scale_in        = true
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_instance_protection" "import" {
+  virtual_machine_scale_set_id = azurerm_virtual_machine_scale_set_instance_protection.test.virtual_machine_scale_set_id
+  instance_id                  = azurerm_virtual_machine_scale_set_instance_protection.test.instance_id
+  protect_from_scale_in        = azurerm_virtual_machine_scale_set_instance_protection.test.protect_from_scale_in
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineScaleSetInstanceProtectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_instance_protection" "test" {
+  virtual_machine_scale_set_id   = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_id                    = data.azurerm_virtual_machine_scale_set.test.instances.0.instance_id
+  protect_from_scale_in          = true
+  protect_from_scale_set_actions = true
+}
+`, r.template(data))
+}
+
+func (VirtualMachineScaleSetInstanceProtectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set" "test" {
+  name                = azurerm_linux_virtual_machine_scale_set.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data))
+}
@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
@@ -142,6 +145,160 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				},
 			},
 
+			"intrusion_detection": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
+								string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
+								string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+							}, false),
+						},
+						"signature_overrides": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"state": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
+											string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
+											string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+										}, false),
+									},
+								},
+							},
+						},
+						"traffic_bypass": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.FirewallPolicyIntrusionDetectionProtocolICMP),
+											string(network.FirewallPolicyIntrusionDetectionProtocolANY),
+											string(network.FirewallPolicyIntrusionDetectionProtocolTCP),
+											string(network.FirewallPolicyIntrusionDetectionProtocolUDP),
+										}, false),
+									},
+									"description": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"destination_addresses": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"destination_ip_groups": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"destination_ports": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"source_addresses": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"source_ip_groups": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+						"user_assigned_identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"tls_certificate": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"child_policies": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -168,9 +325,26 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 
 			"tags": tags.SchemaEnforceLowerCaseKeys(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyCustomizeDiff),
 	}
 }
 
+func resourceFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the SKU defaults to Standard when omitted, so the Premium features require it to be explicitly set
+	if d.Get("sku").(string) == string(network.FirewallPolicySkuTierPremium) {
+		return nil
+	}
+
+	for _, key := range []string{"intrusion_detection", "tls_certificate"} {
+		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("`%s` can only be specified when `sku` is set to `%s`", key, string(network.FirewallPolicySkuTierPremium))
+		}
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -197,7 +371,10 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
 			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
 		},
+		Identity: expandFirewallPolicyIdentity(d.Get("identity").([]interface{})),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -276,6 +453,14 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "dns": %+v`, err)
 		}
 
+		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(prop.IntrusionDetection)); err != nil {
+			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
+		}
+
+		if err := d.Set("tls_certificate", flattenFirewallPolicyTransportSecurity(prop.TransportSecurity)); err != nil {
+			return fmt.Errorf(`setting "tls_certificate": %+v`, err)
+		}
+
 		if err := d.Set("child_policies", flattenNetworkSubResourceID(prop.ChildPolicies)); err != nil {
 			return fmt.Errorf(`setting "child_policies": %+v`, err)
 		}
@@ -289,6 +474,10 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if err := d.Set("identity", flattenFirewallPolicyIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf(`setting "identity": %+v`, err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -378,3 +567,169 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		},
 	}
 }
+
+func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.FirewallPolicyIntrusionDetection {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	var signatureOverrides []network.FirewallPolicyIntrusionDetectionSignatureSpecification
+	for _, v := range raw["signature_overrides"].([]interface{}) {
+		overrides := v.(map[string]interface{})
+		signatureOverrides = append(signatureOverrides, network.FirewallPolicyIntrusionDetectionSignatureSpecification{
+			ID:   utils.String(overrides["id"].(string)),
+			Mode: network.FirewallPolicyIntrusionDetectionStateType(overrides["state"].(string)),
+		})
+	}
+
+	var trafficBypass []network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications
+	for _, v := range raw["traffic_bypass"].([]interface{}) {
+		bypass := v.(map[string]interface{})
+		trafficBypass = append(trafficBypass, network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications{
+			Name:                 utils.String(bypass["name"].(string)),
+			Description:          utils.String(bypass["description"].(string)),
+			Protocol:             network.FirewallPolicyIntrusionDetectionProtocol(bypass["protocol"].(string)),
+			SourceAddresses:      utils.ExpandStringSlice(bypass["source_addresses"].(*pluginsdk.Set).List()),
+			DestinationAddresses: utils.ExpandStringSlice(bypass["destination_addresses"].(*pluginsdk.Set).List()),
+			DestinationPorts:     utils.ExpandStringSlice(bypass["destination_ports"].(*pluginsdk.Set).List()),
+			SourceIPGroups:       utils.ExpandStringSlice(bypass["source_ip_groups"].(*pluginsdk.Set).List()),
+			DestinationIPGroups:  utils.ExpandStringSlice(bypass["destination_ip_groups"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &network.FirewallPolicyIntrusionDetection{
+		Mode: network.FirewallPolicyIntrusionDetectionStateType(raw["mode"].(string)),
+		Configuration: &network.FirewallPolicyIntrusionDetectionConfiguration{
+			SignatureOverrides:    &signatureOverrides,
+			BypassTrafficSettings: &trafficBypass,
+		},
+	}
+}
+
+func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &network.FirewallPolicyTransportSecurity{
+		CertificateAuthority: &network.FirewallPolicyCertificateAuthority{
+			KeyVaultSecretID: utils.String(raw["key_vault_secret_id"].(string)),
+			Name:             utils.String(raw["name"].(string)),
+		},
+	}
+}
+
+func expandFirewallPolicyIdentity(input []interface{}) *network.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	identityIds := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+	for _, id := range raw["user_assigned_identity_ids"].(*pluginsdk.Set).List() {
+		identityIds[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	}
+
+	return &network.ManagedServiceIdentity{
+		Type:                   network.ResourceIdentityType(raw["type"].(string)),
+		UserAssignedIdentities: identityIds,
+	}
+}
+
+func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	signatureOverrides := make([]interface{}, 0)
+	trafficBypass := make([]interface{}, 0)
+	if input.Configuration != nil {
+		if overrides := input.Configuration.SignatureOverrides; overrides != nil {
+			for _, override := range *overrides {
+				id := ""
+				if override.ID != nil {
+					id = *override.ID
+				}
+				signatureOverrides = append(signatureOverrides, map[string]interface{}{
+					"id":    id,
+					"state": string(override.Mode),
+				})
+			}
+		}
+
+		if bypasses := input.Configuration.BypassTrafficSettings; bypasses != nil {
+			for _, bypass := range *bypasses {
+				name := ""
+				if bypass.Name != nil {
+					name = *bypass.Name
+				}
+				description := ""
+				if bypass.Description != nil {
+					description = *bypass.Description
+				}
+				trafficBypass = append(trafficBypass, map[string]interface{}{
+					"name":                  name,
+					"description":           description,
+					"protocol":              string(bypass.Protocol),
+					"source_addresses":      utils.FlattenStringSlice(bypass.SourceAddresses),
+					"destination_addresses": utils.FlattenStringSlice(bypass.DestinationAddresses),
+					"destination_ports":     utils.FlattenStringSlice(bypass.DestinationPorts),
+					"source_ip_groups":      utils.FlattenStringSlice(bypass.SourceIPGroups),
+					"destination_ip_groups": utils.FlattenStringSlice(bypass.DestinationIPGroups),
+				})
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                string(input.Mode),
+			"signature_overrides": signatureOverrides,
+			"traffic_bypass":      trafficBypass,
+		},
+	}
+}
+
+func flattenFirewallPolicyTransportSecurity(input *network.FirewallPolicyTransportSecurity) []interface{} {
+	if input == nil || input.CertificateAuthority == nil {
+		return []interface{}{}
+	}
+
+	keyVaultSecretId := ""
+	if input.CertificateAuthority.KeyVaultSecretID != nil {
+		keyVaultSecretId = *input.CertificateAuthority.KeyVaultSecretID
+	}
+	name := ""
+	if input.CertificateAuthority.Name != nil {
+		name = *input.CertificateAuthority.Name
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_secret_id": keyVaultSecretId,
+			"name":                name,
+		},
+	}
+}
+
+func flattenFirewallPolicyIdentity(input *network.ManagedServiceIdentity) []interface{} {
+	if input == nil || input.Type == network.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                       string(input.Type),
+			"user_assigned_identity_ids": pluginsdk.NewSet(pluginsdk.HashString, identityIds),
+		},
+	}
+}
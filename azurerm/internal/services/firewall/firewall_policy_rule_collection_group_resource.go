@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -152,6 +153,26 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 											ValidateFunc: validation.StringIsNotEmpty,
 										},
 									},
+									"destination_urls": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"terminate_tls": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"web_categories": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
 								},
 							},
 						},
@@ -372,9 +393,46 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyRuleCollectionGroupCustomizeDiff),
 	}
 }
 
+func resourceFirewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !firewallPolicyApplicationRuleCollectionsRequirePremium(d.Get("application_rule_collection").(*pluginsdk.Set).List()) {
+		return nil
+	}
+
+	// the SKU of the Firewall Policy can only be checked once it exists - when it's being created alongside this
+	// resource the `firewall_policy_id` isn't known during the plan, in which case this check happens during the apply
+	// instead (since the diff is recomputed once the Firewall Policy has been created). As the `sku` of a Firewall
+	// Policy is ForceNew, an existing Firewall Policy's SKU can't change without its ID also becoming unknown.
+	if !d.NewValueKnown("firewall_policy_id") {
+		return nil
+	}
+
+	policyId, err := parse.FirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	policy, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		// a Firewall Policy which doesn't exist will instead surface as an error during the apply
+		if utils.ResponseWasNotFound(policy.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Firewall Policy %q (Resource Group %q): %+v", policyId.Name, policyId.ResourceGroup, err)
+	}
+
+	if policy.FirewallPolicyPropertiesFormat == nil || policy.FirewallPolicyPropertiesFormat.Sku == nil || policy.FirewallPolicyPropertiesFormat.Sku.Tier != network.FirewallPolicySkuTierPremium {
+		return fmt.Errorf("`terminate_tls` and `destination_urls` can only be used in an `application_rule_collection` when the Firewall Policy %q (Resource Group %q) has a `sku` of `%s`", policyId.Name, policyId.ResourceGroup, string(network.FirewallPolicySkuTierPremium))
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -399,6 +457,8 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		}
 	}
 
+	applicationRuleCollections := d.Get("application_rule_collection").(*pluginsdk.Set).List()
+
 	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
 	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
 
@@ -408,7 +468,7 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 		},
 	}
 	var rulesCollections []network.BasicFirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(applicationRuleCollections)...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
 	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())...)
 	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
@@ -507,6 +567,21 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+// firewallPolicyApplicationRuleCollectionsRequirePremium returns whether any of the specified Application Rules
+// make use of TLS Inspection, which is only available for Premium Firewall Policies
+func firewallPolicyApplicationRuleCollectionsRequirePremium(input []interface{}) bool {
+	for _, collection := range input {
+		for _, r := range collection.(map[string]interface{})["rule"].(*pluginsdk.Set).List() {
+			rule := r.(map[string]interface{})
+			if rule["terminate_tls"].(bool) || rule["destination_urls"].(*pluginsdk.Set).Len() > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
 	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
 }
@@ -571,6 +646,9 @@ func expandFirewallPolicyRuleApplication(input []interface{}) *[]network.BasicFi
 			SourceIPGroups:  utils.ExpandStringSlice(condition["source_ip_groups"].(*pluginsdk.Set).List()),
 			TargetFqdns:     utils.ExpandStringSlice(condition["destination_fqdns"].(*pluginsdk.Set).List()),
 			FqdnTags:        utils.ExpandStringSlice(condition["destination_fqdn_tags"].(*pluginsdk.Set).List()),
+			TargetUrls:      utils.ExpandStringSlice(condition["destination_urls"].(*pluginsdk.Set).List()),
+			TerminateTLS:    utils.Bool(condition["terminate_tls"].(bool)),
+			WebCategories:   utils.ExpandStringSlice(condition["web_categories"].(*pluginsdk.Set).List()),
 		}
 		result = append(result, output)
 	}
@@ -753,6 +831,11 @@ func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRu
 			}
 		}
 
+		terminateTLS := false
+		if rule.TerminateTLS != nil {
+			terminateTLS = *rule.TerminateTLS
+		}
+
 		output = append(output, map[string]interface{}{
 			"name":                  name,
 			"protocols":             protocols,
@@ -760,6 +843,9 @@ func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRu
 			"source_ip_groups":      utils.FlattenStringSlice(rule.SourceIPGroups),
 			"destination_fqdns":     utils.FlattenStringSlice(rule.TargetFqdns),
 			"destination_fqdn_tags": utils.FlattenStringSlice(rule.FqdnTags),
+			"destination_urls":      utils.FlattenStringSlice(rule.TargetUrls),
+			"terminate_tls":         terminateTLS,
+			"web_categories":        utils.FlattenStringSlice(rule.WebCategories),
 		})
 	}
 
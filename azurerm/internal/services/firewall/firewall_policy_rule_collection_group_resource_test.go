@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -46,6 +47,37 @@ func TestAccFirewallPolicyRuleCollectionGroup_complete(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_premium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroup_premiumRulesOnStandardPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: FirewallPolicyResource{}.basic(data),
+		},
+		{
+			Config:      r.premiumStandardPolicy(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`terminate_tls` and `destination_urls` can only be used in an `application_rule_collection`"),
+		},
+	})
+}
+
 func TestAccFirewallPolicyRuleCollectionGroup_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
 	r := FirewallPolicyRuleCollectionGroupResource{}
@@ -406,6 +438,50 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r FirewallPolicyRuleCollectionGroupResource) premium(data acceptance.TestData) string {
+	return r.premiumRuleCollections(data, FirewallPolicyResource{}.completePremium(data))
+}
+
+func (r FirewallPolicyRuleCollectionGroupResource) premiumStandardPolicy(data acceptance.TestData) string {
+	return r.premiumRuleCollections(data, FirewallPolicyResource{}.basic(data))
+}
+
+func (FirewallPolicyRuleCollectionGroupResource) premiumRuleCollections(data acceptance.TestData, template string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      destination_urls = ["www.example.com/path"]
+      terminate_tls    = true
+    }
+    rule {
+      name = "app_rule_collection1_rule2"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      web_categories   = ["News"]
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
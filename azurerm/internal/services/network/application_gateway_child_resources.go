@@ -0,0 +1,572 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+// The Backend Address Pools, HTTP Listeners, Probes, Request Routing Rules and SSL Certificates within an Application
+// Gateway can be managed either inline within the `azurerm_application_gateway` resource or as standalone resources
+// (e.g. `azurerm_application_gateway_probe`) - both share the schemas and expand/flatten functions for these items.
+
+func applicationGatewayBackendAddressPoolSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+
+		"ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.IPv4Address,
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayHTTPListenerSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_ip_configuration_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"frontend_port_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"host_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"host_names": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"ssl_certificate_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"require_sni": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"frontend_ip_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"frontend_port_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"ssl_certificate_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"custom_error_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"status_code": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+							string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+						}, false),
+					},
+
+					"custom_error_page_url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+	}
+}
+
+func applicationGatewayRequestRoutingRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"rule_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+				string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+			}, false),
+		},
+
+		"http_listener_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"backend_address_pool_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"backend_http_settings_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"url_path_map_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"redirect_configuration_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"rewrite_rule_set_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"backend_address_pool_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"backend_http_settings_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"http_listener_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"url_path_map_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redirect_configuration_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rewrite_rule_set_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewayProbeSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"protocol": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppress.CaseDifference,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ProtocolHTTP),
+				string(network.ProtocolHTTPS),
+			}, true),
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"host": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"interval": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"timeout": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"unhealthy_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validate.PortNumber,
+		},
+
+		"pick_host_name_from_backend_http_settings": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"minimum_servers": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Default:  0,
+		},
+
+		//lintignore:XS003
+		"match": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"body": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+					},
+
+					"status_code": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func applicationGatewaySslCertificateSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"data": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+			StateFunc: base64EncodedStateFunc,
+		},
+
+		"password": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+
+		"key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"public_cert_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// applicationGatewayChildResourceSchema returns the schema for a standalone resource which manages a single item
+// within an Application Gateway, based on the schema for the equivalent block within `azurerm_application_gateway`
+func applicationGatewayChildResourceSchema(itemSchema map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	delete(itemSchema, "id")
+
+	itemSchema["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	itemSchema["application_gateway_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: networkValidate.ApplicationGatewayID,
+	}
+
+	return itemSchema
+}
+
+// expandApplicationGatewayChildResource returns the fields of a standalone child resource in the same shape as the
+// equivalent block within `azurerm_application_gateway`, so that they can be expanded using the same functions
+func expandApplicationGatewayChildResource(d *pluginsdk.ResourceData, itemSchema map[string]*pluginsdk.Schema) []interface{} {
+	item := make(map[string]interface{})
+	for key := range itemSchema {
+		if key == "id" || key == "application_gateway_id" {
+			continue
+		}
+		item[key] = d.Get(key)
+	}
+
+	return []interface{}{item}
+}
+
+// flattenApplicationGatewayChildResource sets the fields of a standalone child resource from a flattened item
+func flattenApplicationGatewayChildResource(d *pluginsdk.ResourceData, itemSchema map[string]*pluginsdk.Schema, item map[string]interface{}) error {
+	for key := range itemSchema {
+		if key == "id" || key == "name" || key == "application_gateway_id" {
+			continue
+		}
+
+		if err := d.Set(key, item[key]); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// findApplicationGatewayFlattenedItem returns the flattened item with the specified name, if it exists
+func findApplicationGatewayFlattenedItem(items []interface{}, name string) map[string]interface{} {
+	for _, raw := range items {
+		item := raw.(map[string]interface{})
+		if v, ok := item["name"].(string); ok && v == name {
+			return item
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGateway retrieves the Application Gateway, applies the specified changes to it and then updates it -
+// the Application Gateway is locked for the duration so that changes made by other resources aren't overwritten
+func updateApplicationGateway(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, update func(props *network.ApplicationGatewayPropertiesFormat) error) error {
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if err := update(existing.ApplicationGatewayPropertiesFormat); err != nil {
+		return err
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// applicationGatewayManagedItemNames returns the names of the items within the specified block which are managed by
+// the `azurerm_application_gateway` resource - which are those in the configuration and those being removed from it
+func applicationGatewayManagedItemNames(d *pluginsdk.ResourceData, key string) map[string]bool {
+	names := make(map[string]bool)
+
+	oldRaw, newRaw := d.GetChange(key)
+	for _, v := range []interface{}{oldRaw, newRaw} {
+		for _, raw := range applicationGatewayBlockItems(v) {
+			if item, ok := raw.(map[string]interface{}); ok {
+				names[item["name"].(string)] = true
+			}
+		}
+	}
+
+	return names
+}
+
+func applicationGatewayBlockItems(input interface{}) []interface{} {
+	if set, ok := input.(*pluginsdk.Set); ok {
+		return set.List()
+	}
+
+	if list, ok := input.([]interface{}); ok {
+		return list
+	}
+
+	return []interface{}{}
+}
+
+// mergeExternallyManagedApplicationGatewayItems appends the items which exist on the Application Gateway but which are
+// managed outside of the `azurerm_application_gateway` resource, so that updating the gateway doesn't remove them
+func mergeExternallyManagedApplicationGatewayItems(d *pluginsdk.ResourceData, gateway *network.ApplicationGatewayPropertiesFormat, existing *network.ApplicationGatewayPropertiesFormat) {
+	if existing.BackendAddressPools != nil {
+		managed := applicationGatewayManagedItemNames(d, "backend_address_pool")
+		for _, item := range *existing.BackendAddressPools {
+			if item.Name != nil && !managed[*item.Name] {
+				*gateway.BackendAddressPools = append(*gateway.BackendAddressPools, item)
+			}
+		}
+	}
+
+	if existing.HTTPListeners != nil {
+		managed := applicationGatewayManagedItemNames(d, "http_listener")
+		for _, item := range *existing.HTTPListeners {
+			if item.Name != nil && !managed[*item.Name] {
+				*gateway.HTTPListeners = append(*gateway.HTTPListeners, item)
+			}
+		}
+	}
+
+	if existing.Probes != nil {
+		managed := applicationGatewayManagedItemNames(d, "probe")
+		for _, item := range *existing.Probes {
+			if item.Name != nil && !managed[*item.Name] {
+				*gateway.Probes = append(*gateway.Probes, item)
+			}
+		}
+	}
+
+	if existing.RequestRoutingRules != nil {
+		managed := applicationGatewayManagedItemNames(d, "request_routing_rule")
+		for _, item := range *existing.RequestRoutingRules {
+			if item.Name != nil && !managed[*item.Name] {
+				*gateway.RequestRoutingRules = append(*gateway.RequestRoutingRules, item)
+			}
+		}
+	}
+
+	if existing.SslCertificates != nil {
+		managed := applicationGatewayManagedItemNames(d, "ssl_certificate")
+		for _, item := range *existing.SslCertificates {
+			if item.Name != nil && !managed[*item.Name] {
+				*gateway.SslCertificates = append(*gateway.SslCertificates, item)
+			}
+		}
+	}
+}
+
+// filterExternallyManagedApplicationGatewayItems removes the flattened items which aren't managed by the
+// `azurerm_application_gateway` resource, so that items managed by other resources don't show up as a diff
+func filterExternallyManagedApplicationGatewayItems(d *pluginsdk.ResourceData, key string, input []interface{}) []interface{} {
+	// when the block has never been set (e.g. during an import) we can't determine which items are managed
+	// by this resource, so all of them are retained rather than treating every item as externally managed
+	// nolint staticcheck
+	if _, exists := d.GetOkExists(key); !exists {
+		return input
+	}
+
+	managed := make(map[string]bool)
+	for _, raw := range applicationGatewayBlockItems(d.Get(key)) {
+		if item, ok := raw.(map[string]interface{}); ok {
+			managed[item["name"].(string)] = true
+		}
+	}
+
+	output := make([]interface{}, 0)
+	for _, raw := range input {
+		item := raw.(map[string]interface{})
+		if name, ok := item["name"].(string); ok && managed[name] {
+			output = append(output, item)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApplicationGatewayProbe() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayProbeCreate,
+		Read:   resourceApplicationGatewayProbeRead,
+		Update: resourceApplicationGatewayProbeUpdate,
+		Delete: resourceApplicationGatewayProbeDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayProbeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayProbeSchema()),
+	}
+}
+
+func resourceApplicationGatewayProbeCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayProbeID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	items := expandApplicationGatewayProbes(expandApplicationGatewayChildResource(d, applicationGatewayProbeSchema()))
+	if err := validateApplicationGatewayProbes(items); err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		existing := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			existing = *props.Probes
+		}
+
+		for _, item := range existing {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.ProbeName) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_probe", id.ID())
+			}
+		}
+
+		existing = append(existing, *items...)
+		props.Probes = &existing
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var item map[string]interface{}
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		item = findApplicationGatewayFlattenedItem(flattenApplicationGatewayProbes(props.Probes), id.ProbeName)
+	}
+	if item == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.ProbeName)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	return flattenApplicationGatewayChildResource(d, applicationGatewayProbeSchema(), item)
+}
+
+func resourceApplicationGatewayProbeUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	items := expandApplicationGatewayProbes(expandApplicationGatewayChildResource(d, applicationGatewayProbeSchema()))
+	if err := validateApplicationGatewayProbes(items); err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.Probes == nil {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		for i, item := range *props.Probes {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.ProbeName) {
+				(*props.Probes)[i] = (*items)[0]
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayProbeRead(d, meta)
+}
+
+func resourceApplicationGatewayProbeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayProbeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		items := make([]network.ApplicationGatewayProbe, 0)
+		if props.Probes != nil {
+			for _, item := range *props.Probes {
+				if item.Name != nil && strings.EqualFold(*item.Name, id.ProbeName) {
+					continue
+				}
+				items = append(items, item)
+			}
+		}
+
+		props.Probes = &items
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApplicationGatewayRequestRoutingRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayRequestRoutingRuleCreate,
+		Read:   resourceApplicationGatewayRequestRoutingRuleRead,
+		Update: resourceApplicationGatewayRequestRoutingRuleUpdate,
+		Delete: resourceApplicationGatewayRequestRoutingRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayRequestRoutingRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewayRequestRoutingRuleSchema()),
+	}
+}
+
+func resourceApplicationGatewayRequestRoutingRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayRequestRoutingRuleID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	items, err := expandApplicationGatewayRequestRoutingRules(expandApplicationGatewayChildResource(d, applicationGatewayRequestRoutingRuleSchema()), gatewayId.ID())
+	if err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		existing := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			existing = *props.RequestRoutingRules
+		}
+
+		for _, item := range existing {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.RequestRoutingRuleName) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_request_routing_rule", id.ID())
+			}
+		}
+
+		existing = append(existing, *items...)
+		props.RequestRoutingRules = &existing
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var item map[string]interface{}
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		items, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)
+		if err != nil {
+			return fmt.Errorf("flattening Request Routing Rules: %+v", err)
+		}
+		item = findApplicationGatewayFlattenedItem(items, id.RequestRoutingRuleName)
+	}
+	if item == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.RequestRoutingRuleName)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	return flattenApplicationGatewayChildResource(d, applicationGatewayRequestRoutingRuleSchema(), item)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	items, err := expandApplicationGatewayRequestRoutingRules(expandApplicationGatewayChildResource(d, applicationGatewayRequestRoutingRuleSchema()), gatewayId.ID())
+	if err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.RequestRoutingRules == nil {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		for i, item := range *props.RequestRoutingRules {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.RequestRoutingRuleName) {
+				(*props.RequestRoutingRules)[i] = (*items)[0]
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewayRequestRoutingRuleRead(d, meta)
+}
+
+func resourceApplicationGatewayRequestRoutingRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayRequestRoutingRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		items := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+		if props.RequestRoutingRules != nil {
+			for _, item := range *props.RequestRoutingRules {
+				if item.Name != nil && strings.EqualFold(*item.Name, id.RequestRoutingRuleName) {
+					continue
+				}
+				items = append(items, item)
+			}
+		}
+
+		props.RequestRoutingRules = &items
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
-	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
@@ -109,36 +109,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"fqdns": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.NoZeroValues,
-							},
-						},
-
-						"ip_addresses": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validate.IPv4Address,
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayBackendAddressPoolSchema(),
 				},
 			},
 
@@ -373,108 +344,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Required: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_ip_configuration_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"frontend_port_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"host_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"host_names": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
-							},
-						},
-
-						"ssl_certificate_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"require_sni": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-						},
-
-						"frontend_ip_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"frontend_port_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"ssl_certificate_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"custom_error_configuration": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"status_code": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ValidateFunc: validation.StringInSlice([]string{
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
-											string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
-										}, false),
-									},
-
-									"custom_error_page_url": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-									},
-
-									"id": {
-										Type:     pluginsdk.TypeString,
-										Computed: true,
-									},
-								},
-							},
-						},
-
-						"firewall_policy_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
-						},
-					},
+					Schema: applicationGatewayHTTPListenerSchema(),
 				},
 			},
 
@@ -483,88 +353,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"rule_type": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
-								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
-							}, false),
-						},
-
-						"http_listener_name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"backend_address_pool_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"backend_http_settings_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"url_path_map_name": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"redirect_configuration_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"rewrite_rule_set_name": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-
-						"backend_address_pool_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"backend_http_settings_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"http_listener_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"url_path_map_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"redirect_configuration_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"rewrite_rule_set_id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayRequestRoutingRuleSchema(),
 				},
 			},
 
@@ -807,98 +596,17 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"ignore_externally_managed_items": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"probe": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"protocol": {
-							Type:             pluginsdk.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ProtocolHTTP),
-								string(network.ProtocolHTTPS),
-							}, true),
-						},
-
-						"path": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"host": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"interval": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"timeout": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"unhealthy_threshold": {
-							Type:     pluginsdk.TypeInt,
-							Required: true,
-						},
-
-						"port": {
-							Type:         pluginsdk.TypeInt,
-							Optional:     true,
-							ValidateFunc: validate.PortNumber,
-						},
-
-						"pick_host_name_from_backend_http_settings": {
-							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"minimum_servers": {
-							Type:     pluginsdk.TypeInt,
-							Optional: true,
-							Default:  0,
-						},
-
-						//lintignore:XS003
-						"match": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Computed: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"body": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-									},
-
-									"status_code": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-										},
-									},
-								},
-							},
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewayProbeSchema(),
 				},
 			},
 
@@ -1030,41 +738,7 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"data": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-							StateFunc: base64EncodedStateFunc,
-						},
-
-						"password": {
-							Type:      pluginsdk.TypeString,
-							Optional:  true,
-							Sensitive: true,
-						},
-
-						"key_vault_secret_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
-						},
-
-						"id": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-
-						"public_cert_data": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
-						},
-					},
+					Schema: applicationGatewaySslCertificateSchema(),
 				},
 			},
 
@@ -1374,6 +1048,9 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enablehttp2 := d.Get("enable_http2").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -1381,7 +1058,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	// Gateway ID is needed to link sub-resources together in expand functions
 	trustedRootCertificates := expandApplicationGatewayTrustedRootCertificates(d.Get("trusted_root_certificate").([]interface{}))
 
-	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d, id.ID())
+	requestRoutingRules, err := expandApplicationGatewayRequestRoutingRules(d.Get("request_routing_rule").(*pluginsdk.Set).List(), id.ID())
 	if err != nil {
 		return fmt.Errorf("Error expanding `request_routing_rule`: %+v", err)
 	}
@@ -1396,14 +1073,14 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("Error expanding `redirect_configuration`: %+v", err)
 	}
 
-	sslCertificates, err := expandApplicationGatewaySslCertificates(d)
+	sslCertificates, err := expandApplicationGatewaySslCertificates(d.Get("ssl_certificate").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("Error expanding `ssl_certificate`: %+v", err)
 	}
 
 	gatewayIPConfigurations, stopApplicationGateway := expandApplicationGatewayIPConfigurations(d)
 
-	httpListeners, err := expandApplicationGatewayHTTPListeners(d, id.ID())
+	httpListeners, err := expandApplicationGatewayHTTPListeners(d.Get("http_listener").([]interface{}), id.ID())
 	if err != nil {
 		return fmt.Errorf("fail to expand `http_listener`: %+v", err)
 	}
@@ -1423,14 +1100,14 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d.Get("authentication_certificate").([]interface{})),
 			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{})),
-			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
+			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d.Get("backend_address_pool").([]interface{})),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
 			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
-			Probes:                        expandApplicationGatewayProbes(d),
+			Probes:                        expandApplicationGatewayProbes(d.Get("probe").([]interface{})),
 			RequestRoutingRules:           requestRoutingRules,
 			RedirectConfigurations:        redirectConfigurations,
 			Sku:                           expandApplicationGatewaySku(d),
@@ -1459,20 +1136,8 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	for _, probe := range *gateway.ApplicationGatewayPropertiesFormat.Probes {
-		if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
-			if props.Host == nil || props.PickHostNameFromBackendHTTPSettings == nil {
-				continue
-			}
-
-			if *props.Host == "" && !*props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
-			}
-
-			if *props.Host != "" && *props.PickHostNameFromBackendHTTPSettings {
-				return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
-			}
-		}
+	if err := validateApplicationGatewayProbes(gateway.ApplicationGatewayPropertiesFormat.Probes); err != nil {
+		return err
 	}
 
 	if _, ok := d.GetOk("waf_configuration"); ok {
@@ -1493,6 +1158,19 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	// Backend Address Pools, HTTP Listeners, Probes, Request Routing Rules and SSL Certificates can also be managed
+	// using their own resources, in which case we need to retain those which aren't defined within this resource
+	if d.Get("ignore_externally_managed_items").(bool) && !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := existing.ApplicationGatewayPropertiesFormat; props != nil {
+			mergeExternallyManagedApplicationGatewayItems(d, gateway.ApplicationGatewayPropertiesFormat, props)
+		}
+	}
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1564,6 +1242,9 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	ignoreExternallyManagedItems := d.Get("ignore_externally_managed_items").(bool)
+	d.Set("ignore_externally_managed_items", ignoreExternallyManagedItems)
+
 	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil {
 		if err = d.Set("authentication_certificate", flattenApplicationGatewayAuthenticationCertificates(props.AuthenticationCertificates, d)); err != nil {
 			return fmt.Errorf("Error setting `authentication_certificate`: %+v", err)
@@ -1573,7 +1254,11 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `trusted_root_certificate`: %+v", err)
 		}
 
-		if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+		backendAddressPools := flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)
+		if ignoreExternallyManagedItems {
+			backendAddressPools = filterExternallyManagedApplicationGatewayItems(d, "backend_address_pool", backendAddressPools)
+		}
+		if setErr := d.Set("backend_address_pool", backendAddressPools); setErr != nil {
 			return fmt.Errorf("Error setting `backend_address_pool`: %+v", setErr)
 		}
 
@@ -1595,6 +1280,9 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("Error flattening `http_listener`: %+v", err)
 		}
+		if ignoreExternallyManagedItems {
+			httpListeners = filterExternallyManagedApplicationGatewayItems(d, "http_listener", httpListeners)
+		}
 		if setErr := d.Set("http_listener", httpListeners); setErr != nil {
 			return fmt.Errorf("Error setting `http_listener`: %+v", setErr)
 		}
@@ -1611,7 +1299,11 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `gateway_ip_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); setErr != nil {
+		probes := flattenApplicationGatewayProbes(props.Probes)
+		if ignoreExternallyManagedItems {
+			probes = filterExternallyManagedApplicationGatewayItems(d, "probe", probes)
+		}
+		if setErr := d.Set("probe", probes); setErr != nil {
 			return fmt.Errorf("Error setting `probe`: %+v", setErr)
 		}
 
@@ -1619,6 +1311,9 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("Error flattening `request_routing_rule`: %+v", err)
 		}
+		if ignoreExternallyManagedItems {
+			requestRoutingRules = filterExternallyManagedApplicationGatewayItems(d, "request_routing_rule", requestRoutingRules)
+		}
 		if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
 			return fmt.Errorf("Error setting `request_routing_rule`: %+v", setErr)
 		}
@@ -1644,7 +1339,11 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `autoscale_configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		sslCertificates := flattenApplicationGatewaySslCertificates(props.SslCertificates, d.Get("ssl_certificate").([]interface{}))
+		if ignoreExternallyManagedItems {
+			sslCertificates = filterExternallyManagedApplicationGatewayItems(d, "ssl_certificate", sslCertificates)
+		}
+		if setErr := d.Set("ssl_certificate", sslCertificates); setErr != nil {
 			return fmt.Errorf("Error setting `ssl_certificate`: %+v", setErr)
 		}
 
@@ -1682,6 +1381,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -1873,8 +1575,7 @@ func flattenApplicationGatewayTrustedRootCertificates(certs *[]network.Applicati
 	return results
 }
 
-func expandApplicationGatewayBackendAddressPools(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
-	vs := d.Get("backend_address_pool").([]interface{})
+func expandApplicationGatewayBackendAddressPools(vs []interface{}) *[]network.ApplicationGatewayBackendAddressPool {
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
 
 	for _, raw := range vs {
@@ -2248,8 +1949,7 @@ func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPoli
 	return results
 }
 
-func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
-	vs := d.Get("http_listener").([]interface{})
+func expandApplicationGatewayHTTPListeners(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
 	results := make([]network.ApplicationGatewayHTTPListener, 0)
 
 	for _, raw := range vs {
@@ -2612,8 +2312,7 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 	return results
 }
 
-func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayProbe {
-	vs := d.Get("probe").([]interface{})
+func expandApplicationGatewayProbes(vs []interface{}) *[]network.ApplicationGatewayProbe {
 	results := make([]network.ApplicationGatewayProbe, 0)
 
 	for _, raw := range vs {
@@ -2672,6 +2371,30 @@ func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.Applic
 	return &results
 }
 
+func validateApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) error {
+	if input == nil {
+		return nil
+	}
+
+	for _, probe := range *input {
+		if props := probe.ApplicationGatewayProbePropertiesFormat; props != nil {
+			if props.Host == nil || props.PickHostNameFromBackendHTTPSettings == nil {
+				continue
+			}
+
+			if *props.Host == "" && !*props.PickHostNameFromBackendHTTPSettings {
+				return fmt.Errorf("One of `host` or `pick_host_name_from_backend_http_settings` must be set")
+			}
+
+			if *props.Host != "" && *props.PickHostNameFromBackendHTTPSettings {
+				return fmt.Errorf("Only one of `host` or `pick_host_name_from_backend_http_settings` can be set")
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -2751,8 +2474,7 @@ func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) [
 	return results
 }
 
-func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
-	vs := d.Get("request_routing_rule").(*pluginsdk.Set).List()
+func expandApplicationGatewayRequestRoutingRules(vs []interface{}, gatewayID string) (*[]network.ApplicationGatewayRequestRoutingRule, error) {
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
 
 	for _, raw := range vs {
@@ -3313,8 +3035,7 @@ func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interf
 	return []interface{}{result}
 }
 
-func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewaySslCertificate, error) {
-	vs := d.Get("ssl_certificate").([]interface{})
+func expandApplicationGatewaySslCertificates(vs []interface{}) (*[]network.ApplicationGatewaySslCertificate, error) {
 	results := make([]network.ApplicationGatewaySslCertificate, 0)
 
 	for _, raw := range vs {
@@ -3357,7 +3078,7 @@ func expandApplicationGatewaySslCertificates(d *pluginsdk.ResourceData) (*[]netw
 	return &results, nil
 }
 
-func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *pluginsdk.ResourceData) []interface{} {
+func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -3388,21 +3109,18 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 		}
 
 		// since the certificate data isn't returned we have to load it from the same index
-		if existing, ok := d.GetOk("ssl_certificate"); ok && existing != nil {
-			existingVals := existing.([]interface{})
-			for _, existingVal := range existingVals {
-				existingCerts := existingVal.(map[string]interface{})
-				existingName := existingCerts["name"].(string)
-
-				if name == existingName {
-					if data := existingCerts["data"]; data != nil {
-						v := utils.Base64EncodeIfNot(data.(string))
-						output["data"] = v
-					}
+		for _, existingVal := range existing {
+			existingCerts := existingVal.(map[string]interface{})
+			existingName := existingCerts["name"].(string)
+
+			if name == existingName {
+				if data := existingCerts["data"]; data != nil {
+					v := utils.Base64EncodeIfNot(data.(string))
+					output["data"] = v
+				}
 
-					if password := existingCerts["password"]; password != nil {
-						output["password"] = password.(string)
-					}
+				if password := existingCerts["password"]; password != nil {
+					output["password"] = password.(string)
 				}
 			}
 		}
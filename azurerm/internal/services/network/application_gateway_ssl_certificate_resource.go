@@ -0,0 +1,189 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApplicationGatewaySslCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewaySslCertificateCreate,
+		Read:   resourceApplicationGatewaySslCertificateRead,
+		Update: resourceApplicationGatewaySslCertificateUpdate,
+		Delete: resourceApplicationGatewaySslCertificateDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewaySslCertificateID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: applicationGatewayChildResourceSchema(applicationGatewaySslCertificateSchema()),
+	}
+}
+
+func resourceApplicationGatewaySslCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewaySslCertificateID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+	items, err := expandApplicationGatewaySslCertificates(expandApplicationGatewayChildResource(d, applicationGatewaySslCertificateSchema()))
+	if err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, *gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		existing := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			existing = *props.SslCertificates
+		}
+
+		for _, item := range existing {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.SslCertificateName) {
+				return tf.ImportAsExistsError("azurerm_application_gateway_ssl_certificate", id.ID())
+			}
+		}
+
+		existing = append(existing, *items...)
+		props.SslCertificates = &existing
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewaySslCertificateRead(d, meta)
+}
+
+func resourceApplicationGatewaySslCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewaySslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var item map[string]interface{}
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		// the certificate data and password aren't returned, so these are loaded from the existing state
+		existing := expandApplicationGatewayChildResource(d, applicationGatewaySslCertificateSchema())
+		item = findApplicationGatewayFlattenedItem(flattenApplicationGatewaySslCertificates(props.SslCertificates, existing), id.SslCertificateName)
+	}
+	if item == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.SslCertificateName)
+	d.Set("application_gateway_id", gatewayId.ID())
+
+	return flattenApplicationGatewayChildResource(d, applicationGatewaySslCertificateSchema(), item)
+}
+
+func resourceApplicationGatewaySslCertificateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewaySslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	items, err := expandApplicationGatewaySslCertificates(expandApplicationGatewayChildResource(d, applicationGatewaySslCertificateSchema()))
+	if err != nil {
+		return err
+	}
+
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		if props.SslCertificates == nil {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		for i, item := range *props.SslCertificates {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.SslCertificateName) {
+				(*props.SslCertificates)[i] = (*items)[0]
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s was not found", *id)
+	})
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceApplicationGatewaySslCertificateRead(d, meta)
+}
+
+func resourceApplicationGatewaySslCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewaySslCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	err = updateApplicationGateway(ctx, client, gatewayId, func(props *network.ApplicationGatewayPropertiesFormat) error {
+		items := make([]network.ApplicationGatewaySslCertificate, 0)
+		if props.SslCertificates != nil {
+			for _, item := range *props.SslCertificates {
+				if item.Name != nil && strings.EqualFold(*item.Name, id.SslCertificateName) {
+					continue
+				}
+				items = append(items, item)
+			}
+		}
+
+		props.SslCertificates = &items
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
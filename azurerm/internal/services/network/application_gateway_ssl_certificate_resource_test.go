@@ -0,0 +1,138 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ApplicationGatewaySslCertificateResource struct {
+}
+
+func TestAccApplicationGatewaySslCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+	})
+}
+
+func TestAccApplicationGatewaySslCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationGatewaySslCertificate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_ssl_certificate", "test")
+	r := ApplicationGatewaySslCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data", "password"),
+	})
+}
+
+func (t ApplicationGatewaySslCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewaySslCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway %q (Resource Group %q): %+v", id.ApplicationGatewayName, id.ResourceGroup, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.SslCertificates != nil {
+		for _, item := range *props.SslCertificates {
+			if item.Name != nil && strings.EqualFold(*item.Name, id.SslCertificateName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewaySslCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "test" {
+  name                   = "acctest-external-sslcert"
+  application_gateway_id = azurerm_application_gateway.test.id
+  data                   = filebase64("testdata/application_gateway_test.pfx")
+  password               = "terraform"
+}
+`, ApplicationGatewayResource{}.ignoreExternallyManagedItems(data))
+}
+
+func (r ApplicationGatewaySslCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "import" {
+  name                   = azurerm_application_gateway_ssl_certificate.test.name
+  application_gateway_id = azurerm_application_gateway_ssl_certificate.test.application_gateway_id
+  data                   = filebase64("testdata/application_gateway_test.pfx")
+  password               = "terraform"
+}
+`, r.basic(data))
+}
+
+func (ApplicationGatewaySslCertificateResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_ssl_certificate" "test" {
+  name                   = "acctest-external-sslcert"
+  application_gateway_id = azurerm_application_gateway.test.id
+  data                   = filebase64("testdata/application_gateway_test_2.pfx")
+  password               = "hello-world"
+}
+`, ApplicationGatewayResource{}.ignoreExternallyManagedItems(data))
+}
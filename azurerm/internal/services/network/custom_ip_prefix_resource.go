@@ -0,0 +1,352 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/sdk/customipprefixes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceCustomIpPrefix() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCustomIpPrefixCreate,
+		Read:   resourceCustomIpPrefixRead,
+		Update: resourceCustomIpPrefixUpdate,
+		Delete: resourceCustomIpPrefixDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := customipprefixes.CustomIpPrefixID(id)
+			return err
+		}),
+
+		// validating, commissioning and decommissioning a range can each take several hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(9 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(9 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(9 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cidr": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"signed_message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"authorization_message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"commissioning_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zones": azure.SchemaMultipleZones(),
+
+			"commissioned_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCustomIpPrefixCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := customipprefixes.NewCustomIpPrefixID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_custom_ip_prefix", id.ID())
+	}
+
+	parameters := customipprefixes.CustomIpPrefix{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Properties: &customipprefixes.CustomIpPrefixPropertiesFormat{
+			Cidr: utils.String(d.Get("cidr").(string)),
+		},
+		Zones: azure.ExpandZones(d.Get("zones").([]interface{})),
+		Tags:  expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	// the signed message and authorization message are used to validate the ownership of the range with the
+	// Regional Internet Registry
+	if v, ok := d.GetOk("signed_message"); ok {
+		parameters.Properties.SignedMessage = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("authorization_message"); ok {
+		parameters.Properties.AuthorizationMessage = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the range is validated against the Regional Internet Registry before it's Provisioned, during which the
+	// Commissioned State may not be populated
+	pending := []string{"", "Validating", string(customipprefixes.CommissionedStateProvisioning)}
+	if err := waitForCustomIpPrefixCommissionedState(ctx, client, id, pending, string(customipprefixes.CommissionedStateProvisioned)); err != nil {
+		return err
+	}
+
+	if d.Get("commissioning_enabled").(bool) {
+		if err := updateCustomIpPrefixCommissionedState(ctx, client, id, customipprefixes.CommissionedStateCommissioning); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := customipprefixes.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+		d.Set("zones", azure.FlattenZones(model.Zones))
+
+		if props := model.Properties; props != nil {
+			d.Set("cidr", props.Cidr)
+			d.Set("signed_message", props.SignedMessage)
+			d.Set("authorization_message", props.AuthorizationMessage)
+
+			commissionedState := ""
+			if props.CommissionedState != nil {
+				commissionedState = string(*props.CommissionedState)
+			}
+			d.Set("commissioned_state", commissionedState)
+
+			commissioningEnabled := commissionedState == string(customipprefixes.CommissionedStateCommissioning) || commissionedState == string(customipprefixes.CommissionedStateCommissioned)
+			d.Set("commissioning_enabled", commissioningEnabled)
+		}
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceCustomIpPrefixUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := customipprefixes.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := customipprefixes.TagsObject{
+			Tags: expandTags(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("commissioning_enabled") {
+		targetState := customipprefixes.CommissionedStateDecommissioning
+		if d.Get("commissioning_enabled").(bool) {
+			targetState = customipprefixes.CommissionedStateCommissioning
+		}
+
+		if err := updateCustomIpPrefixCommissionedState(ctx, client, *id, targetState); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := customipprefixes.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a range which is (being) advertised has to be decommissioned before it can be deleted
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.CommissionedState != nil {
+		state := *model.Properties.CommissionedState
+		if state == customipprefixes.CommissionedStateCommissioning || state == customipprefixes.CommissionedStateCommissioned {
+			if err := updateCustomIpPrefixCommissionedState(ctx, client, *id, customipprefixes.CommissionedStateDecommissioning); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// updateCustomIpPrefixCommissionedState moves the Custom IP Prefix into either the `Commissioning` or `Decommissioning`
+// state and then waits for it to settle in `Commissioned` or `Provisioned` respectively
+func updateCustomIpPrefixCommissionedState(ctx context.Context, client *customipprefixes.CustomIPPrefixesClient, id customipprefixes.CustomIpPrefixId, state customipprefixes.CommissionedState) error {
+	target := customipprefixes.CommissionedStateCommissioned
+	if state == customipprefixes.CommissionedStateDecommissioning {
+		target = customipprefixes.CommissionedStateProvisioned
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	currentState := ""
+	if v := existing.Model.Properties.CommissionedState; v != nil {
+		currentState = string(*v)
+	}
+
+	// the range may still be transitioning following a previous operation
+	if currentState == string(target) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating the Commissioned State for %s to %q..", id, string(state))
+	existing.Model.Properties.CommissionedState = &state
+	if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+		return fmt.Errorf("updating the Commissioned State for %s to %q: %+v", id, string(state), err)
+	}
+
+	// the previous state can still be returned until the change has been picked up
+	return waitForCustomIpPrefixCommissionedState(ctx, client, id, []string{currentState, string(state)}, string(target))
+}
+
+func waitForCustomIpPrefixCommissionedState(ctx context.Context, client *customipprefixes.CustomIPPrefixesClient, id customipprefixes.CustomIpPrefixId, pending []string, target string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for the Commissioned State for %s to become %q..", id, target)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{target},
+		Refresh:      customIpPrefixCommissionedStateRefreshFunc(ctx, client, id),
+		MinTimeout:   1 * time.Minute,
+		PollInterval: 1 * time.Minute,
+		Timeout:      time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Commissioned State for %s to become %q: %+v", id, target, err)
+	}
+
+	return nil
+}
+
+func customIpPrefixCommissionedStateRefreshFunc(ctx context.Context, client *customipprefixes.CustomIPPrefixesClient, id customipprefixes.CustomIpPrefixId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		if v := resp.Model.Properties.FailedReason; v != nil && *v != "" {
+			return nil, "", fmt.Errorf("%s failed: %s", id, *v)
+		}
+
+		state := ""
+		if v := resp.Model.Properties.CommissionedState; v != nil {
+			state = string(*v)
+		}
+
+		return resp, state, nil
+	}
+}
@@ -0,0 +1,209 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/sdk/customipprefixes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type CustomIpPrefixResource struct {
+	cidr                 string
+	signedMessage        string
+	authorizationMessage string
+}
+
+func newCustomIpPrefixResource(t *testing.T) CustomIpPrefixResource {
+	// a range which is owned by the test subscription (and registered with a Regional Internet Registry) is needed
+	// to test this resource, which needs to be set as the environment variable ARM_TEST_CUSTOM_IP_PREFIX_CIDR along
+	// with the messages used to validate it in ARM_TEST_CUSTOM_IP_PREFIX_SIGNED_MESSAGE and
+	// ARM_TEST_CUSTOM_IP_PREFIX_AUTHORIZATION_MESSAGE
+	cidr := os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_CIDR")
+	signedMessage := os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_SIGNED_MESSAGE")
+	authorizationMessage := os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_AUTHORIZATION_MESSAGE")
+	if cidr == "" || signedMessage == "" || authorizationMessage == "" {
+		t.Skip("Skipping as ARM_TEST_CUSTOM_IP_PREFIX_CIDR, ARM_TEST_CUSTOM_IP_PREFIX_SIGNED_MESSAGE and ARM_TEST_CUSTOM_IP_PREFIX_AUTHORIZATION_MESSAGE are not specified")
+	}
+
+	return CustomIpPrefixResource{
+		cidr:                 cidr,
+		signedMessage:        signedMessage,
+		authorizationMessage: authorizationMessage,
+	}
+}
+
+func TestAccCustomIpPrefix_basic(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Provisioned"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomIpPrefix_requiresImport(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCustomIpPrefix_commissioning(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Commissioned"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Provisioned"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomIpPrefix_publicIpPrefix(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicIpPrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_public_ip_prefix.test").Key("custom_ip_prefix_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (CustomIpPrefixResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := customipprefixes.CustomIpPrefixID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.CustomIPPrefixesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CustomIpPrefixResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cipp-%d"
+  location = "%s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                  = "acctest-cipp-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cidr                  = "%s"
+  signed_message        = "%s"
+  authorization_message = "%s"
+  zones                 = ["1", "2", "3"]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, r.cidr, r.signedMessage, r.authorizationMessage)
+}
+
+func (r CustomIpPrefixResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "import" {
+  name                  = azurerm_custom_ip_prefix.test.name
+  resource_group_name   = azurerm_custom_ip_prefix.test.resource_group_name
+  location              = azurerm_custom_ip_prefix.test.location
+  cidr                  = azurerm_custom_ip_prefix.test.cidr
+  signed_message        = azurerm_custom_ip_prefix.test.signed_message
+  authorization_message = azurerm_custom_ip_prefix.test.authorization_message
+  zones                 = azurerm_custom_ip_prefix.test.zones
+}
+`, r.basic(data))
+}
+
+func (r CustomIpPrefixResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cipp-%d"
+  location = "%s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                  = "acctest-cipp-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  cidr                  = "%s"
+  signed_message        = "%s"
+  authorization_message = "%s"
+  zones                 = ["1", "2", "3"]
+  commissioning_enabled = true
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, r.cidr, r.signedMessage, r.authorizationMessage)
+}
+
+func (r CustomIpPrefixResource) publicIpPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctest-pipp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  prefix_length       = 31
+  custom_ip_prefix_id = azurerm_custom_ip_prefix.test.id
+}
+`, r.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,44 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkDscpConfigurationDataSource struct {
+}
+
+func TestAccDataSourceNetworkDscpConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("markings.#").HasValue("2"),
+				check.That(data.ResourceName).Key("protocol").HasValue("Udp"),
+				check.That(data.ResourceName).Key("source_ip_range.#").HasValue("1"),
+				check.That(data.ResourceName).Key("destination_ip_range.#").HasValue("1"),
+				check.That(data.ResourceName).Key("source_port_ranges.#").HasValue("2"),
+				check.That(data.ResourceName).Key("destination_port_ranges.0").HasValue("3478-3481"),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (NetworkDscpConfigurationDataSource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_dscp_configuration" "test" {
+  name                = azurerm_network_dscp_configuration.test.name
+  resource_group_name = azurerm_network_dscp_configuration.test.resource_group_name
+}
+`, NetworkDscpConfigurationResource{}.complete(data))
+}
@@ -0,0 +1,419 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkDscpConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkDscpConfigurationCreateUpdate,
+		Read:   resourceNetworkDscpConfigurationRead,
+		Update: resourceNetworkDscpConfigurationCreateUpdate,
+		Delete: resourceNetworkDscpConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DscpConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkDscpConfigurationCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"markings": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 63),
+				},
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.ProtocolTypeAll),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolTypeAh),
+					string(network.ProtocolTypeAll),
+					string(network.ProtocolTypeEsp),
+					string(network.ProtocolTypeGre),
+					string(network.ProtocolTypeIcmp),
+					string(network.ProtocolTypeTCP),
+					string(network.ProtocolTypeUDP),
+					string(network.ProtocolTypeVxlan),
+				}, false),
+			},
+
+			"source_ip_range": networkDscpConfigurationIPRangeSchema(),
+
+			"destination_ip_range": networkDscpConfigurationIPRangeSchema(),
+
+			"source_port_ranges": networkDscpConfigurationPortRangesSchema(),
+
+			"destination_port_ranges": networkDscpConfigurationPortRangesSchema(),
+
+			"associated_network_interface_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"qos_collection_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func networkDscpConfigurationIPRangeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"start_ip": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+
+				"end_ip": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+	}
+}
+
+func networkDscpConfigurationPortRangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validate.PortOrPortRangeWithin(0, 65535),
+		},
+	}
+}
+
+func resourceNetworkDscpConfigurationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"source_port_ranges", "destination_port_ranges"} {
+		portRanges := make([]string, 0)
+		for _, v := range d.Get(key).([]interface{}) {
+			// values which aren't known yet can't be checked until they are
+			if v == nil || v.(string) == "" {
+				continue
+			}
+			portRanges = append(portRanges, v.(string))
+		}
+
+		if err := validate.PortRangesDoNotOverlap(portRanges); err != nil {
+			return fmt.Errorf("`%s`: %+v", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkDscpConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DscpConfigurationClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDscpConfigurationID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_network_dscp_configuration", id.ID())
+		}
+	}
+
+	parameters := network.DscpConfiguration{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		DscpConfigurationPropertiesFormat: &network.DscpConfigurationPropertiesFormat{
+			Markings:              expandNetworkDscpConfigurationMarkings(d.Get("markings").([]interface{})),
+			Protocol:              network.ProtocolType(d.Get("protocol").(string)),
+			SourceIPRanges:        expandNetworkDscpConfigurationIPRanges(d.Get("source_ip_range").([]interface{})),
+			DestinationIPRanges:   expandNetworkDscpConfigurationIPRanges(d.Get("destination_ip_range").([]interface{})),
+			SourcePortRanges:      expandNetworkDscpConfigurationPortRanges(d.Get("source_port_ranges").([]interface{})),
+			DestinationPortRanges: expandNetworkDscpConfigurationPortRanges(d.Get("destination_port_ranges").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkDscpConfigurationRead(d, meta)
+}
+
+func resourceNetworkDscpConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DscpConfigurationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DscpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if err := setNetworkDscpConfigurationProperties(d, resp.DscpConfigurationPropertiesFormat); err != nil {
+		return err
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetworkDscpConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.DscpConfigurationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DscpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func setNetworkDscpConfigurationProperties(d *pluginsdk.ResourceData, props *network.DscpConfigurationPropertiesFormat) error {
+	if props == nil {
+		return nil
+	}
+
+	if err := d.Set("markings", flattenNetworkDscpConfigurationMarkings(props.Markings)); err != nil {
+		return fmt.Errorf("setting `markings`: %+v", err)
+	}
+
+	d.Set("protocol", string(props.Protocol))
+
+	if err := d.Set("source_ip_range", flattenNetworkDscpConfigurationIPRanges(props.SourceIPRanges)); err != nil {
+		return fmt.Errorf("setting `source_ip_range`: %+v", err)
+	}
+
+	if err := d.Set("destination_ip_range", flattenNetworkDscpConfigurationIPRanges(props.DestinationIPRanges)); err != nil {
+		return fmt.Errorf("setting `destination_ip_range`: %+v", err)
+	}
+
+	if err := d.Set("source_port_ranges", flattenNetworkDscpConfigurationPortRanges(props.SourcePortRanges)); err != nil {
+		return fmt.Errorf("setting `source_port_ranges`: %+v", err)
+	}
+
+	if err := d.Set("destination_port_ranges", flattenNetworkDscpConfigurationPortRanges(props.DestinationPortRanges)); err != nil {
+		return fmt.Errorf("setting `destination_port_ranges`: %+v", err)
+	}
+
+	networkInterfaceIds := make([]interface{}, 0)
+	if props.AssociatedNetworkInterfaces != nil {
+		for _, nic := range *props.AssociatedNetworkInterfaces {
+			if nic.ID != nil {
+				networkInterfaceIds = append(networkInterfaceIds, *nic.ID)
+			}
+		}
+	}
+	if err := d.Set("associated_network_interface_ids", networkInterfaceIds); err != nil {
+		return fmt.Errorf("setting `associated_network_interface_ids`: %+v", err)
+	}
+
+	qosCollectionId := ""
+	if props.QosCollectionID != nil {
+		qosCollectionId = *props.QosCollectionID
+	}
+	d.Set("qos_collection_id", qosCollectionId)
+
+	return nil
+}
+
+func expandNetworkDscpConfigurationMarkings(input []interface{}) *[]int32 {
+	markings := make([]int32, 0)
+	for _, v := range input {
+		markings = append(markings, int32(v.(int)))
+	}
+	return &markings
+}
+
+func flattenNetworkDscpConfigurationMarkings(input *[]int32) []interface{} {
+	markings := make([]interface{}, 0)
+	if input == nil {
+		return markings
+	}
+
+	for _, v := range *input {
+		markings = append(markings, int(v))
+	}
+	return markings
+}
+
+func expandNetworkDscpConfigurationIPRanges(input []interface{}) *[]network.QosIPRange {
+	ranges := make([]network.QosIPRange, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		ranges = append(ranges, network.QosIPRange{
+			StartIP: utils.String(v["start_ip"].(string)),
+			EndIP:   utils.String(v["end_ip"].(string)),
+		})
+	}
+	return &ranges
+}
+
+func flattenNetworkDscpConfigurationIPRanges(input *[]network.QosIPRange) []interface{} {
+	ranges := make([]interface{}, 0)
+	if input == nil {
+		return ranges
+	}
+
+	for _, item := range *input {
+		startIp := ""
+		if item.StartIP != nil {
+			startIp = *item.StartIP
+		}
+
+		endIp := ""
+		if item.EndIP != nil {
+			endIp = *item.EndIP
+		}
+
+		ranges = append(ranges, map[string]interface{}{
+			"start_ip": startIp,
+			"end_ip":   endIp,
+		})
+	}
+	return ranges
+}
+
+func expandNetworkDscpConfigurationPortRanges(input []interface{}) *[]network.QosPortRange {
+	ranges := make([]network.QosPortRange, 0)
+	for _, item := range input {
+		// the format (`num` or `num1-num2`) has already been validated by the schema
+		ports := strings.SplitN(item.(string), "-", 2)
+		start, _ := strconv.Atoi(ports[0])
+		end := start
+		if len(ports) == 2 {
+			end, _ = strconv.Atoi(ports[1])
+		}
+
+		ranges = append(ranges, network.QosPortRange{
+			Start: utils.Int32(int32(start)),
+			End:   utils.Int32(int32(end)),
+		})
+	}
+	return &ranges
+}
+
+func flattenNetworkDscpConfigurationPortRanges(input *[]network.QosPortRange) []interface{} {
+	ranges := make([]interface{}, 0)
+	if input == nil {
+		return ranges
+	}
+
+	for _, item := range *input {
+		start := 0
+		if item.Start != nil {
+			start = int(*item.Start)
+		}
+
+		end := start
+		if item.End != nil {
+			end = int(*item.End)
+		}
+
+		if start == end {
+			ranges = append(ranges, fmt.Sprintf("%d", start))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", start, end))
+		}
+	}
+	return ranges
+}
@@ -0,0 +1,202 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkDscpConfigurationResource struct {
+}
+
+func TestAccNetworkDscpConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("qos_collection_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDscpConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkDscpConfiguration_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("markings.#").HasValue("2"),
+				check.That(data.ResourceName).Key("source_port_ranges.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDscpConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkDscpConfiguration_overlappingPortRanges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_dscp_configuration", "test")
+	r := NetworkDscpConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.overlappingPortRanges(data),
+			ExpectError: regexp.MustCompile("overlaps with port range"),
+		},
+	})
+}
+
+func (NetworkDscpConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DscpConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.DscpConfigurationClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (NetworkDscpConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dscp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkDscpConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_dscp_configuration" "test" {
+  name                = "acctest-dscp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  markings            = [46]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkDscpConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_dscp_configuration" "import" {
+  name                = azurerm_network_dscp_configuration.test.name
+  resource_group_name = azurerm_network_dscp_configuration.test.resource_group_name
+  location            = azurerm_network_dscp_configuration.test.location
+  markings            = azurerm_network_dscp_configuration.test.markings
+}
+`, r.basic(data))
+}
+
+func (r NetworkDscpConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_dscp_configuration" "test" {
+  name                = "acctest-dscp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  markings            = [46, 10]
+  protocol            = "Udp"
+
+  source_ip_range {
+    start_ip = "10.0.0.1"
+    end_ip   = "10.0.0.254"
+  }
+
+  destination_ip_range {
+    start_ip = "10.1.0.1"
+    end_ip   = "10.1.0.254"
+  }
+
+  source_port_ranges      = ["3478", "49152-65535"]
+  destination_port_ranges = ["3478-3481"]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkDscpConfigurationResource) overlappingPortRanges(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_dscp_configuration" "test" {
+  name                = "acctest-dscp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  markings            = [46]
+  protocol            = "Udp"
+
+  source_port_ranges = ["1000-2000", "1500"]
+}
+`, r.template(data), data.RandomInteger)
+}
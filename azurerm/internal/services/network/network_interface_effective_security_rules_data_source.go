@@ -0,0 +1,218 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceEffectiveSecurityRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceEffectiveSecurityRulesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"effective_security_rule": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"network_security_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"protocol": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"priority": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"access": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"direction": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"source_port_ranges": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"destination_port_ranges": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"source_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"destination_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"expanded_source_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"expanded_destination_address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceEffectiveSecurityRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkInterfaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the Effective Network Security Groups for %s: %+v", id, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving Effective Network Security Groups for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("effective_security_rule", flattenNetworkInterfaceEffectiveSecurityRules(resp.Value)); err != nil {
+		return fmt.Errorf("setting `effective_security_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenNetworkInterfaceEffectiveSecurityRules flattens the rules from each of the effective Network Security Groups
+// into a single list, since rules from the Network Interface and Subnet NSGs are both evaluated for the interface
+func flattenNetworkInterfaceEffectiveSecurityRules(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		if group.EffectiveSecurityRules == nil {
+			continue
+		}
+
+		networkSecurityGroupId := ""
+		if group.NetworkSecurityGroup != nil && group.NetworkSecurityGroup.ID != nil {
+			networkSecurityGroupId = *group.NetworkSecurityGroup.ID
+		}
+
+		for _, rule := range *group.EffectiveSecurityRules {
+			name := ""
+			if rule.Name != nil {
+				name = *rule.Name
+			}
+
+			priority := 0
+			if rule.Priority != nil {
+				priority = int(*rule.Priority)
+			}
+
+			results = append(results, map[string]interface{}{
+				"network_security_group_id":             networkSecurityGroupId,
+				"name":                                  name,
+				"protocol":                              string(rule.Protocol),
+				"priority":                              priority,
+				"access":                                string(rule.Access),
+				"direction":                             string(rule.Direction),
+				"source_port_ranges":                    flattenEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges),
+				"destination_port_ranges":               flattenEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges),
+				"source_address_prefixes":               flattenEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+				"destination_address_prefixes":          flattenEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+				"expanded_source_address_prefixes":      utils.FlattenStringSlice(rule.ExpandedSourceAddressPrefix),
+				"expanded_destination_address_prefixes": utils.FlattenStringSlice(rule.ExpandedDestinationAddressPrefix),
+			})
+		}
+	}
+
+	return results
+}
+
+// flattenEffectiveSecurityRuleValues combines the singular and plural forms of a field, since the API returns
+// whichever of these was used to define the rule
+func flattenEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	if multiple != nil && len(*multiple) > 0 {
+		return utils.FlattenStringSlice(multiple)
+	}
+
+	results := make([]interface{}, 0)
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+	return results
+}
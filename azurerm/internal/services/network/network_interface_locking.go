@@ -1,6 +1,9 @@
 package network
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -60,3 +63,33 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 		virtualNetworkNamesToLock: virtualNetworkNamesToLock,
 	}, nil
 }
+
+// lockNetworkInterfaceForTapConfiguration locks the Network Interface along with the Subnets and Virtual Networks
+// referenced by its IP Configurations, since Azure updates the Network Interface when a Tap Configuration changes
+func lockNetworkInterfaceForTapConfiguration(ctx context.Context, client *network.InterfacesClient, id parse.NetworkInterfaceId) (func(), error) {
+	locks.ByName(id.Name, networkInterfaceResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		locks.UnlockByName(id.Name, networkInterfaceResourceName)
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var ipConfigurations *[]network.InterfaceIPConfiguration
+	if props := existing.InterfacePropertiesFormat; props != nil {
+		ipConfigurations = props.IPConfigurations
+	}
+
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigurations)
+	if err != nil {
+		locks.UnlockByName(id.Name, networkInterfaceResourceName)
+		return nil, fmt.Errorf("determining locking details for %s: %+v", id, err)
+	}
+
+	lockingDetails.lock()
+
+	return func() {
+		lockingDetails.unlock()
+		locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	}, nil
+}
@@ -360,6 +360,9 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	// this can be managed in another resource, so just port it over
 	update.InterfacePropertiesFormat.NetworkSecurityGroup = existing.InterfacePropertiesFormat.NetworkSecurityGroup
 
+	// the Tap Configurations are managed in the `azurerm_network_interface_tap_configuration_association` resource
+	update.InterfacePropertiesFormat.TapConfigurations = existing.InterfacePropertiesFormat.TapConfigurations
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, update)
 	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkInterfaceTapConfigurationAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkInterfaceTapConfigurationAssociationCreate,
+		Read:   resourceNetworkInterfaceTapConfigurationAssociationRead,
+		Delete: resourceNetworkInterfaceTapConfigurationAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceTapConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"virtual_network_tap_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualNetworkTapID,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfaceTapConfigurationAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	networkInterfaceId, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tapId, err := parse.VirtualNetworkTapID(d.Get("virtual_network_tap_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkInterfaceTapConfigurationID(networkInterfaceId.SubscriptionId, networkInterfaceId.ResourceGroup, networkInterfaceId.Name, d.Get("name").(string))
+
+	unlock, err := lockNetworkInterfaceForTapConfiguration(ctx, meta.(*clients.Client).Network.InterfacesClient, *networkInterfaceId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	locks.ByName(tapId.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(tapId.Name, virtualNetworkTapResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_network_interface_tap_configuration_association", id.ID())
+	}
+
+	parameters := network.InterfaceTapConfiguration{
+		Name: utils.String(id.TapConfigurationName),
+		InterfaceTapConfigurationPropertiesFormat: &network.InterfaceTapConfigurationPropertiesFormat{
+			VirtualNetworkTap: &network.VirtualNetworkTap{
+				ID: utils.String(tapId.ID()),
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkInterfaceTapConfigurationAssociationRead(d, meta)
+}
+
+func resourceNetworkInterfaceTapConfigurationAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TapConfigurationName)
+	d.Set("network_interface_id", parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName).ID())
+
+	tapId := ""
+	if props := resp.InterfaceTapConfigurationPropertiesFormat; props != nil && props.VirtualNetworkTap != nil && props.VirtualNetworkTap.ID != nil {
+		parsed, err := parse.VirtualNetworkTapID(*props.VirtualNetworkTap.ID)
+		if err != nil {
+			return err
+		}
+		tapId = parsed.ID()
+	}
+	d.Set("virtual_network_tap_id", tapId)
+
+	return nil
+}
+
+func resourceNetworkInterfaceTapConfigurationAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfaceTapConfigurationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceTapConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	networkInterfaceId := parse.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName)
+	unlock, err := lockNetworkInterfaceForTapConfiguration(ctx, meta.(*clients.Client).Network.InterfacesClient, networkInterfaceId)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkInterfaceTapConfigurationAssociationResource struct {
+}
+
+func TestAccNetworkInterfaceTapConfigurationAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration_association", "test")
+	r := NetworkInterfaceTapConfigurationAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceTapConfigurationAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration_association", "test")
+	r := NetworkInterfaceTapConfigurationAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkInterfaceTapConfigurationAssociation_updateNetworkInterface(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration_association", "test")
+	r := NetworkInterfaceTapConfigurationAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Network Interface mustn't remove the Tap Configuration
+			Config: r.networkInterfaceUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (NetworkInterfaceTapConfigurationAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkInterfaceTapConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.InterfaceTapConfigurationsClient.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r NetworkInterfaceTapConfigurationAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "source" {
+  name                = "acctestnic-source-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface_tap_configuration_association" "test" {
+  name                   = "acctesttapconfig-%d"
+  network_interface_id   = azurerm_network_interface.source.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, VirtualNetworkTapResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r NetworkInterfaceTapConfigurationAssociationResource) networkInterfaceUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "source" {
+  name                = "acctestnic-source-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+
+resource "azurerm_network_interface_tap_configuration_association" "test" {
+  name                   = "acctesttapconfig-%d"
+  network_interface_id   = azurerm_network_interface.source.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, VirtualNetworkTapResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r NetworkInterfaceTapConfigurationAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_tap_configuration_association" "import" {
+  name                   = azurerm_network_interface_tap_configuration_association.test.name
+  network_interface_id   = azurerm_network_interface_tap_configuration_association.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_tap_configuration_association.test.virtual_network_tap_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,200 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkVirtualAppliance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkVirtualApplianceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"virtual_hub_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vendor": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"bundled_scale_unit": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"market_place_version": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"virtual_appliance_asn": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"boot_strap_configuration_blobs": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"cloud_init_configuration_blobs": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_appliance_nics": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceNetworkVirtualApplianceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkVirtualApplianceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualAppliancePropertiesFormat; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			virtualHubId = *props.VirtualHub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := d.Set("sku", flattenNetworkVirtualApplianceSku(props.NvaSku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		var asn int64
+		if props.VirtualApplianceAsn != nil {
+			asn = *props.VirtualApplianceAsn
+		}
+		d.Set("virtual_appliance_asn", asn)
+
+		d.Set("address_prefix", props.AddressPrefix)
+
+		if err := d.Set("boot_strap_configuration_blobs", utils.FlattenStringSlice(props.BootStrapConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `boot_strap_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("cloud_init_configuration_blobs", utils.FlattenStringSlice(props.CloudInitConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `cloud_init_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("virtual_appliance_nics", flattenNetworkVirtualApplianceNics(props.VirtualApplianceNics)); err != nil {
+			return fmt.Errorf("setting `virtual_appliance_nics`: %+v", err)
+		}
+	}
+
+	if err := d.Set("identity", flattenNetworkVirtualApplianceIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
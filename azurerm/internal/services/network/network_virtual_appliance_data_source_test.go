@@ -0,0 +1,40 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkVirtualApplianceDataSource struct {
+}
+
+func TestAccDataSourceNetworkVirtualAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_virtual_appliance", "test")
+	r := NetworkVirtualApplianceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("virtual_hub_id").Exists(),
+				check.That(data.ResourceName).Key("sku.0.vendor").HasValue("barracudasdwanrelease"),
+				check.That(data.ResourceName).Key("virtual_appliance_asn").HasValue("64512"),
+				check.That(data.ResourceName).Key("address_prefix").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkVirtualApplianceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_virtual_appliance" "test" {
+  name                = azurerm_network_virtual_appliance.test.name
+  resource_group_name = azurerm_network_virtual_appliance.test.resource_group_name
+}
+`, NetworkVirtualApplianceResource{}.basic(data))
+}
@@ -0,0 +1,468 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkVirtualAppliance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkVirtualApplianceCreateUpdate,
+		Read:   resourceNetworkVirtualApplianceRead,
+		Update: resourceNetworkVirtualApplianceCreateUpdate,
+		Delete: resourceNetworkVirtualApplianceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkVirtualApplianceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_hub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.VirtualHubID,
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vendor": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"bundled_scale_unit": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"market_place_version": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"virtual_appliance_asn": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"boot_strap_configuration_blobs": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+			},
+
+			"cloud_init_configuration_blobs": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+				ConflictsWith: []string{"cloud_init_configuration"},
+			},
+
+			"cloud_init_configuration": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"cloud_init_configuration_blobs"},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+					},
+				},
+			},
+
+			"address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"virtual_appliance_nics": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"public_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetworkVirtualApplianceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewNetworkVirtualApplianceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_network_virtual_appliance", id.ID())
+		}
+	}
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	parameters := network.VirtualAppliance{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualAppliancePropertiesFormat: &network.VirtualAppliancePropertiesFormat{
+			NvaSku: expandNetworkVirtualApplianceSku(d.Get("sku").([]interface{})),
+			VirtualHub: &network.SubResource{
+				ID: utils.String(virtualHubId.ID()),
+			},
+			BootStrapConfigurationBlobs: utils.ExpandStringSlice(d.Get("boot_strap_configuration_blobs").([]interface{})),
+			CloudInitConfigurationBlobs: utils.ExpandStringSlice(d.Get("cloud_init_configuration_blobs").([]interface{})),
+		},
+		Identity: expandNetworkVirtualApplianceIdentity(d.Get("identity").([]interface{})),
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("cloud_init_configuration"); ok {
+		parameters.VirtualAppliancePropertiesFormat.CloudInitConfiguration = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("virtual_appliance_asn"); ok {
+		parameters.VirtualAppliancePropertiesFormat.VirtualApplianceAsn = utils.Int64(int64(v.(int)))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkVirtualApplianceRead(d, meta)
+}
+
+func resourceNetworkVirtualApplianceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualAppliancePropertiesFormat; props != nil {
+		virtualHubId := ""
+		if props.VirtualHub != nil && props.VirtualHub.ID != nil {
+			hubId, err := parse.VirtualHubID(*props.VirtualHub.ID)
+			if err != nil {
+				return err
+			}
+			virtualHubId = hubId.ID()
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := d.Set("sku", flattenNetworkVirtualApplianceSku(props.NvaSku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		var asn int64
+		if props.VirtualApplianceAsn != nil {
+			asn = *props.VirtualApplianceAsn
+		}
+		d.Set("virtual_appliance_asn", asn)
+
+		d.Set("address_prefix", props.AddressPrefix)
+
+		if err := d.Set("boot_strap_configuration_blobs", utils.FlattenStringSlice(props.BootStrapConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `boot_strap_configuration_blobs`: %+v", err)
+		}
+
+		if err := d.Set("cloud_init_configuration_blobs", utils.FlattenStringSlice(props.CloudInitConfigurationBlobs)); err != nil {
+			return fmt.Errorf("setting `cloud_init_configuration_blobs`: %+v", err)
+		}
+
+		// the API doesn't return the plain-text cloud init configuration, so we keep the value from the config
+
+		if err := d.Set("virtual_appliance_nics", flattenNetworkVirtualApplianceNics(props.VirtualApplianceNics)); err != nil {
+			return fmt.Errorf("setting `virtual_appliance_nics`: %+v", err)
+		}
+	}
+
+	if err := d.Set("identity", flattenNetworkVirtualApplianceIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetworkVirtualApplianceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualApplianceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkVirtualApplianceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	virtualHubId, err := parse.VirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(virtualHubId.Name, virtualHubResourceName)
+	defer locks.UnlockByName(virtualHubId.Name, virtualHubResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandNetworkVirtualApplianceSku(input []interface{}) *network.VirtualApplianceSkuProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &network.VirtualApplianceSkuProperties{
+		Vendor:             utils.String(raw["vendor"].(string)),
+		BundledScaleUnit:   utils.String(raw["bundled_scale_unit"].(string)),
+		MarketPlaceVersion: utils.String(raw["market_place_version"].(string)),
+	}
+}
+
+func flattenNetworkVirtualApplianceSku(input *network.VirtualApplianceSkuProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	vendor := ""
+	if input.Vendor != nil {
+		vendor = *input.Vendor
+	}
+
+	bundledScaleUnit := ""
+	if input.BundledScaleUnit != nil {
+		bundledScaleUnit = *input.BundledScaleUnit
+	}
+
+	marketPlaceVersion := ""
+	if input.MarketPlaceVersion != nil {
+		marketPlaceVersion = *input.MarketPlaceVersion
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vendor":               vendor,
+			"bundled_scale_unit":   bundledScaleUnit,
+			"market_place_version": marketPlaceVersion,
+		},
+	}
+}
+
+func expandNetworkVirtualApplianceIdentity(input []interface{}) *network.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	identityIds := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+	for _, id := range raw["identity_ids"].(*pluginsdk.Set).List() {
+		identityIds[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	}
+
+	return &network.ManagedServiceIdentity{
+		Type:                   network.ResourceIdentityType(raw["type"].(string)),
+		UserAssignedIdentities: identityIds,
+	}
+}
+
+func flattenNetworkVirtualApplianceIdentity(input *network.ManagedServiceIdentity) []interface{} {
+	if input == nil || strings.EqualFold(string(input.Type), string(network.ResourceIdentityTypeNone)) {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": pluginsdk.NewSet(pluginsdk.HashString, identityIds),
+		},
+	}
+}
+
+func flattenNetworkVirtualApplianceNics(input *[]network.VirtualApplianceNicProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateIPAddress := ""
+		if item.PrivateIPAddress != nil {
+			privateIPAddress = *item.PrivateIPAddress
+		}
+
+		publicIPAddress := ""
+		if item.PublicIPAddress != nil {
+			publicIPAddress = *item.PublicIPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"private_ip_address": privateIPAddress,
+			"public_ip_address":  publicIPAddress,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,410 @@
+package network
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkWatcherConnectivity() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherConnectivityRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: networkWatcherDiagnosticsSchema(map[string]*pluginsdk.Schema{
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"destination": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"address": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"destination.0.resource_id", "destination.0.address"},
+						},
+
+						"port": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.ProtocolTCP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+					string(network.ProtocolIcmp),
+					string(network.ProtocolTCP),
+				}, false),
+			},
+
+			"preferred_ip_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPVersionIPv4),
+					string(network.IPVersionIPv6),
+				}, false),
+			},
+
+			"http_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"method": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(network.HTTPMethodGet),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.HTTPMethodGet),
+							}, false),
+						},
+
+						"valid_status_codes": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeInt,
+								ValidateFunc: validation.IntBetween(http.StatusContinue, 599),
+							},
+						},
+
+						"header": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"value": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"average_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"minimum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"maximum_latency_in_ms": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_sent": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"probes_failed": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"hop": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"issue": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"origin": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"severity": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceNetworkWatcherConnectivityRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := findNetworkWatcherForDiagnostics(ctx, client, d)
+	if err != nil {
+		return err
+	}
+
+	parameters := network.ConnectivityParameters{
+		Source:                expandNetworkWatcherConnectivitySource(d.Get("source").([]interface{})),
+		Destination:           expandNetworkWatcherConnectivityDestination(d.Get("destination").([]interface{})),
+		Protocol:              network.Protocol(d.Get("protocol").(string)),
+		ProtocolConfiguration: expandNetworkWatcherConnectivityHTTPConfiguration(d.Get("http_configuration").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("preferred_ip_version"); ok {
+		parameters.PreferredIPVersion = network.IPVersion(v.(string))
+	}
+
+	future, err := client.CheckConnectivity(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("checking connectivity using %s: %+v", *watcherId, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the connectivity check using %s: %+v", *watcherId, err)
+	}
+
+	resp, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the connectivity check result from %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := setNetworkWatcherForDiagnostics(ctx, client, d, *watcherId); err != nil {
+		return err
+	}
+
+	d.Set("connection_status", string(resp.ConnectionStatus))
+	d.Set("average_latency_in_ms", resp.AvgLatencyInMs)
+	d.Set("minimum_latency_in_ms", resp.MinLatencyInMs)
+	d.Set("maximum_latency_in_ms", resp.MaxLatencyInMs)
+	d.Set("probes_sent", resp.ProbesSent)
+	d.Set("probes_failed", resp.ProbesFailed)
+
+	if err := d.Set("hop", flattenNetworkWatcherConnectivityHops(resp.Hops)); err != nil {
+		return fmt.Errorf("setting `hop`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandNetworkWatcherConnectivitySource(input []interface{}) *network.ConnectivitySource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := &network.ConnectivitySource{
+		ResourceID: utils.String(v["resource_id"].(string)),
+	}
+
+	if port := v["port"].(int); port != 0 {
+		output.Port = utils.Int32(int32(port))
+	}
+
+	return output
+}
+
+func expandNetworkWatcherConnectivityDestination(input []interface{}) *network.ConnectivityDestination {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	output := &network.ConnectivityDestination{}
+
+	if resourceId := v["resource_id"].(string); resourceId != "" {
+		output.ResourceID = utils.String(resourceId)
+	}
+
+	if address := v["address"].(string); address != "" {
+		output.Address = utils.String(address)
+	}
+
+	if port := v["port"].(int); port != 0 {
+		output.Port = utils.Int32(int32(port))
+	}
+
+	return output
+}
+
+func expandNetworkWatcherConnectivityHTTPConfiguration(input []interface{}) *network.ProtocolConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	statusCodes := make([]int32, 0)
+	for _, statusCode := range v["valid_status_codes"].([]interface{}) {
+		statusCodes = append(statusCodes, int32(statusCode.(int)))
+	}
+
+	headers := make([]network.HTTPHeader, 0)
+	for _, raw := range v["header"].([]interface{}) {
+		header := raw.(map[string]interface{})
+		headers = append(headers, network.HTTPHeader{
+			Name:  utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+
+	return &network.ProtocolConfiguration{
+		HTTPConfiguration: &network.HTTPConfiguration{
+			Method:           network.HTTPMethod(v["method"].(string)),
+			ValidStatusCodes: &statusCodes,
+			Headers:          &headers,
+		},
+	}
+}
+
+func flattenNetworkWatcherConnectivityHops(input *[]network.ConnectivityHop) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		hopType := ""
+		if item.Type != nil {
+			hopType = *item.Type
+		}
+
+		address := ""
+		if item.Address != nil {
+			address = *item.Address
+		}
+
+		resourceId := ""
+		if item.ResourceID != nil {
+			resourceId = *item.ResourceID
+		}
+
+		issues := make([]interface{}, 0)
+		if item.Issues != nil {
+			for _, issue := range *item.Issues {
+				issues = append(issues, map[string]interface{}{
+					"origin":   string(issue.Origin),
+					"severity": string(issue.Severity),
+					"type":     string(issue.Type),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":           id,
+			"type":         hopType,
+			"address":      address,
+			"resource_id":  resourceId,
+			"next_hop_ids": utils.FlattenStringSlice(item.NextHopIds),
+			"issue":        issues,
+		})
+	}
+
+	return results
+}
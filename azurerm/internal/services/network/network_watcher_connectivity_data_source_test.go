@@ -0,0 +1,84 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherConnectivityDataSource struct {
+}
+
+func testAccDataSourceNetworkWatcherConnectivity_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity", "test")
+	r := NetworkWatcherConnectivityDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("connection_status").HasValue("Reachable"),
+				check.That(data.ResourceName).Key("hop.#").Exists(),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherConnectivity_location(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_connectivity", "test")
+	r := NetworkWatcherConnectivityDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.location(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_watcher_id").Exists(),
+				check.That(data.ResourceName).Key("connection_status").HasValue("Reachable"),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherConnectivityDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+
+  source {
+    resource_id = azurerm_virtual_machine.src.id
+  }
+
+  destination {
+    resource_id = azurerm_virtual_machine.dest.id
+    port        = 22
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, NetworkConnectionMonitorResource{}.baseWithDestConfig(data))
+}
+
+func (NetworkWatcherConnectivityDataSource) location(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_connectivity" "test" {
+  location = azurerm_network_watcher.test.location
+
+  source {
+    resource_id = azurerm_virtual_machine.src.id
+  }
+
+  destination {
+    address = azurerm_network_interface.dest.private_ip_address
+    port    = 22
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, NetworkConnectionMonitorResource{}.baseWithDestConfig(data))
+}
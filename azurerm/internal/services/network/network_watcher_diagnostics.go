@@ -0,0 +1,76 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// The Network Watcher diagnostics data sources run against the Network Watcher in the same region as the resources
+// being diagnosed - which can either be specified directly, or looked up from the region since Azure creates a single
+// Network Watcher per region within each subscription.
+
+// networkWatcherDiagnosticsSchema adds the fields used to select the Network Watcher to the specified schema
+func networkWatcherDiagnosticsSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	input["network_watcher_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.NetworkWatcherID,
+		ExactlyOneOf: []string{"network_watcher_id", "location"},
+	}
+
+	input["location"] = &pluginsdk.Schema{
+		Type:             pluginsdk.TypeString,
+		Optional:         true,
+		Computed:         true,
+		StateFunc:        location.StateFunc,
+		DiffSuppressFunc: location.DiffSuppressFunc,
+		ExactlyOneOf:     []string{"network_watcher_id", "location"},
+	}
+
+	return input
+}
+
+// findNetworkWatcherForDiagnostics returns the ID of the Network Watcher specified in the configuration, or of the
+// Network Watcher in the specified region when this isn't specified
+func findNetworkWatcherForDiagnostics(ctx context.Context, client *network.WatchersClient, d *pluginsdk.ResourceData) (*parse.NetworkWatcherId, error) {
+	if v := d.Get("network_watcher_id").(string); v != "" {
+		return parse.NetworkWatcherID(v)
+	}
+
+	loc := location.Normalize(d.Get("location").(string))
+	resp, err := client.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Network Watchers: %+v", err)
+	}
+
+	if resp.Value != nil {
+		for _, watcher := range *resp.Value {
+			if watcher.ID == nil || location.NormalizeNilable(watcher.Location) != loc {
+				continue
+			}
+
+			return parse.NetworkWatcherID(*watcher.ID)
+		}
+	}
+
+	return nil, fmt.Errorf("no Network Watcher was found in %q - a Network Watcher is required to run diagnostics within a region", loc)
+}
+
+// setNetworkWatcherForDiagnostics sets the fields used to select the Network Watcher
+func setNetworkWatcherForDiagnostics(ctx context.Context, client *network.WatchersClient, d *pluginsdk.ResourceData, id parse.NetworkWatcherId) error {
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("network_watcher_id", id.ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	return nil
+}
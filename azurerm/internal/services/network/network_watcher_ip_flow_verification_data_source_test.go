@@ -0,0 +1,44 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherIPFlowVerificationDataSource struct {
+}
+
+func testAccDataSourceNetworkWatcherIPFlowVerification_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verification", "test")
+	r := NetworkWatcherIPFlowVerificationDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherIPFlowVerificationDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verification" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.src.id
+  direction          = "Outbound"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.src.private_ip_address
+  local_port         = "*"
+  remote_ip_address  = azurerm_network_interface.dest.private_ip_address
+  remote_port        = "22"
+}
+`, NetworkConnectionMonitorResource{}.baseWithDestConfig(data))
+}
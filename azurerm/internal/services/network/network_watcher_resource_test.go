@@ -36,6 +36,13 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceNetworkWatcher_basic,
 		},
+		"Diagnostics": {
+			"connectivity":         testAccDataSourceNetworkWatcherConnectivity_basic,
+			"connectivityLocation": testAccDataSourceNetworkWatcherConnectivity_location,
+			"ipFlowVerification":   testAccDataSourceNetworkWatcherIPFlowVerification_basic,
+			"nextHop":              testAccDataSourceNetworkWatcherNextHop_basic,
+			"topology":             testAccDataSourceNetworkWatcherTopology_basic,
+		},
 		"PacketCaptureOld": {
 			"localDisk":                  testAccPacketCapture_localDisk,
 			"storageAccount":             testAccPacketCapture_storageAccount,
@@ -0,0 +1,186 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceNetworkWatcherTopology() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkWatcherTopologyRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: networkWatcherDiagnosticsSchema(map[string]*pluginsdk.Schema{
+			"target_resource_group_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceGroupName,
+				ExactlyOneOf: []string{"target_resource_group_name", "target_virtual_network_id", "target_subnet_id"},
+			},
+
+			"target_virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+				ExactlyOneOf: []string{"target_resource_group_name", "target_virtual_network_id", "target_subnet_id"},
+			},
+
+			"target_subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.SubnetID,
+				ExactlyOneOf: []string{"target_resource_group_name", "target_virtual_network_id", "target_subnet_id"},
+			},
+
+			"resource": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"association": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"resource_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func dataSourceNetworkWatcherTopologyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.WatcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	watcherId, err := findNetworkWatcherForDiagnostics(ctx, client, d)
+	if err != nil {
+		return err
+	}
+
+	parameters := network.TopologyParameters{}
+	if v, ok := d.GetOk("target_resource_group_name"); ok {
+		parameters.TargetResourceGroupName = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("target_virtual_network_id"); ok {
+		parameters.TargetVirtualNetwork = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+	if v, ok := d.GetOk("target_subnet_id"); ok {
+		parameters.TargetSubnet = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	resp, err := client.GetTopology(ctx, watcherId.ResourceGroup, watcherId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("retrieving the Topology using %s: %+v", *watcherId, err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := setNetworkWatcherForDiagnostics(ctx, client, d, *watcherId); err != nil {
+		return err
+	}
+
+	if err := d.Set("resource", flattenNetworkWatcherTopologyResources(resp.Resources)); err != nil {
+		return fmt.Errorf("setting `resource`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenNetworkWatcherTopologyResources(input *[]network.TopologyResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		associations := make([]interface{}, 0)
+		if item.Associations != nil {
+			for _, association := range *item.Associations {
+				associationName := ""
+				if association.Name != nil {
+					associationName = *association.Name
+				}
+
+				resourceId := ""
+				if association.ResourceID != nil {
+					resourceId = *association.ResourceID
+				}
+
+				associations = append(associations, map[string]interface{}{
+					"name":        associationName,
+					"resource_id": resourceId,
+					"type":        string(association.AssociationType),
+				})
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":        name,
+			"id":          id,
+			"location":    location.NormalizeNilable(item.Location),
+			"association": associations,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,40 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherTopologyDataSource struct {
+}
+
+func testAccDataSourceNetworkWatcherTopology_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_topology", "test")
+	r := NetworkWatcherTopologyDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("resource.#").Exists(),
+				check.That(data.ResourceName).Key("resource.0.id").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkWatcherTopologyDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_topology" "test" {
+  network_watcher_id        = azurerm_network_watcher.test.id
+  target_virtual_network_id = azurerm_virtual_network.test.id
+
+  depends_on = [azurerm_virtual_machine.src]
+}
+`, NetworkConnectionMonitorResource{}.baseConfig(data))
+}
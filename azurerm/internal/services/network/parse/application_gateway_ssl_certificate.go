@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApplicationGatewaySslCertificateId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	SslCertificateName     string
+}
+
+func NewApplicationGatewaySslCertificateID(subscriptionId, resourceGroup, applicationGatewayName, sslCertificateName string) ApplicationGatewaySslCertificateId {
+	return ApplicationGatewaySslCertificateId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		SslCertificateName:     sslCertificateName,
+	}
+}
+
+func (id ApplicationGatewaySslCertificateId) String() string {
+	segments := []string{
+		fmt.Sprintf("Ssl Certificate Name %q", id.SslCertificateName),
+		fmt.Sprintf("Application Gateway Name %q", id.ApplicationGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Gateway Ssl Certificate", segmentsStr)
+}
+
+func (id ApplicationGatewaySslCertificateId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/sslCertificates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.SslCertificateName)
+}
+
+// ApplicationGatewaySslCertificateID parses a ApplicationGatewaySslCertificate ID into an ApplicationGatewaySslCertificateId struct
+func ApplicationGatewaySslCertificateID(input string) (*ApplicationGatewaySslCertificateId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationGatewaySslCertificateId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.SslCertificateName, err = id.PopSegment("sslCertificates"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
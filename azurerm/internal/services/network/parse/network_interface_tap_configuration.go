@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type NetworkInterfaceTapConfigurationId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	NetworkInterfaceName string
+	TapConfigurationName string
+}
+
+func NewNetworkInterfaceTapConfigurationID(subscriptionId, resourceGroup, networkInterfaceName, tapConfigurationName string) NetworkInterfaceTapConfigurationId {
+	return NetworkInterfaceTapConfigurationId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		TapConfigurationName: tapConfigurationName,
+	}
+}
+
+func (id NetworkInterfaceTapConfigurationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Tap Configuration Name %q", id.TapConfigurationName),
+		fmt.Sprintf("Network Interface Name %q", id.NetworkInterfaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Interface Tap Configuration", segmentsStr)
+}
+
+func (id NetworkInterfaceTapConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkInterfaces/%s/tapConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkInterfaceName, id.TapConfigurationName)
+}
+
+// NetworkInterfaceTapConfigurationID parses a NetworkInterfaceTapConfiguration ID into an NetworkInterfaceTapConfigurationId struct
+func NetworkInterfaceTapConfigurationID(input string) (*NetworkInterfaceTapConfigurationId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkInterfaceTapConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkInterfaceName, err = id.PopSegment("networkInterfaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TapConfigurationName, err = id.PopSegment("tapConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
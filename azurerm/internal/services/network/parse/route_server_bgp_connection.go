@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type RouteServerBgpConnectionId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	VirtualHubName    string
+	BgpConnectionName string
+}
+
+func NewRouteServerBgpConnectionID(subscriptionId, resourceGroup, virtualHubName, bgpConnectionName string) RouteServerBgpConnectionId {
+	return RouteServerBgpConnectionId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		VirtualHubName:    virtualHubName,
+		BgpConnectionName: bgpConnectionName,
+	}
+}
+
+func (id RouteServerBgpConnectionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Bgp Connection Name %q", id.BgpConnectionName),
+		fmt.Sprintf("Virtual Hub Name %q", id.VirtualHubName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Route Server Bgp Connection", segmentsStr)
+}
+
+func (id RouteServerBgpConnectionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualHubs/%s/bgpConnections/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.BgpConnectionName)
+}
+
+// RouteServerBgpConnectionID parses a RouteServerBgpConnection ID into an RouteServerBgpConnectionId struct
+func RouteServerBgpConnectionID(input string) (*RouteServerBgpConnectionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := RouteServerBgpConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.BgpConnectionName, err = id.PopSegment("bgpConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
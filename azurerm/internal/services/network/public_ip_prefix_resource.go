@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
@@ -82,6 +83,13 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 31),
 			},
 
+			"custom_ip_prefix_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomIpPrefixID,
+			},
+
 			"ip_prefix": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -168,6 +176,12 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Zones: zones,
 	}
 
+	if v, ok := d.GetOk("custom_ip_prefix_id"); ok {
+		publicIpPrefix.PublicIPPrefixPropertiesFormat.CustomIPPrefix = &network.SubResource{
+			ID: utils.String(v.(string)),
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PublicIPPrefixeName, publicIpPrefix)
 	if err != nil {
 		return fmt.Errorf("creating/Updating %s: %+v", id, err)
@@ -229,6 +243,12 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 	if props := resp.PublicIPPrefixPropertiesFormat; props != nil {
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
+
+		customIpPrefixId := ""
+		if props.CustomIPPrefix != nil && props.CustomIPPrefix.ID != nil {
+			customIpPrefixId = *props.CustomIPPrefix.ID
+		}
+		d.Set("custom_ip_prefix_id", customIpPrefixId)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
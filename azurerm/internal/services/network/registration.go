@@ -21,58 +21,75 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
-		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
-		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
-		"azurerm_ip_group":                                  dataSourceIpGroup(),
-		"azurerm_nat_gateway":                               dataSourceNatGateway(),
-		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                         dataSourceNetworkInterface(),
-		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
-		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
-		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
-		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
-		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
-		"azurerm_public_ip":                                 dataSourcePublicIP(),
-		"azurerm_public_ips":                                dataSourcePublicIPs(),
-		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
-		"azurerm_route_filter":                              dataSourceRouteFilter(),
-		"azurerm_route_table":                               dataSourceRouteTable(),
-		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
-		"azurerm_subnet":                                    dataSourceSubnet(),
-		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
-		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
-		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
-		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
+		"azurerm_application_gateway":                        dataSourceApplicationGateway(),
+		"azurerm_application_security_group":                 dataSourceApplicationSecurityGroup(),
+		"azurerm_express_route_circuit":                      dataSourceExpressRouteCircuit(),
+		"azurerm_ip_group":                                   dataSourceIpGroup(),
+		"azurerm_nat_gateway":                                dataSourceNatGateway(),
+		"azurerm_network_ddos_protection_plan":               dataSourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_dscp_configuration":                 dataSourceNetworkDscpConfiguration(),
+		"azurerm_network_interface":                          dataSourceNetworkInterface(),
+		"azurerm_network_interface_effective_routes":         dataSourceNetworkInterfaceEffectiveRoutes(),
+		"azurerm_network_interface_effective_security_rules": dataSourceNetworkInterfaceEffectiveSecurityRules(),
+		"azurerm_network_security_group":                     dataSourceNetworkSecurityGroup(),
+		"azurerm_network_virtual_appliance":                  dataSourceNetworkVirtualAppliance(),
+		"azurerm_network_watcher":                            dataSourceNetworkWatcher(),
+		"azurerm_network_watcher_connectivity":               dataSourceNetworkWatcherConnectivity(),
+		"azurerm_network_watcher_ip_flow_verification":       dataSourceNetworkWatcherIPFlowVerification(),
+		"azurerm_network_watcher_next_hop":                   dataSourceNetworkWatcherNextHop(),
+		"azurerm_network_watcher_topology":                   dataSourceNetworkWatcherTopology(),
+		"azurerm_private_endpoint_connection":                dataSourcePrivateEndpointConnection(),
+		"azurerm_private_link_service":                       dataSourcePrivateLinkService(),
+		"azurerm_private_link_service_endpoint_connections":  dataSourcePrivateLinkServiceEndpointConnections(),
+		"azurerm_public_ip":                                  dataSourcePublicIP(),
+		"azurerm_public_ips":                                 dataSourcePublicIPs(),
+		"azurerm_public_ip_prefix":                           dataSourcePublicIpPrefix(),
+		"azurerm_route_filter":                               dataSourceRouteFilter(),
+		"azurerm_route_table":                                dataSourceRouteTable(),
+		"azurerm_network_service_tags":                       dataSourceNetworkServiceTags(),
+		"azurerm_subnet":                                     dataSourceSubnet(),
+		"azurerm_virtual_hub":                                dataSourceVirtualHub(),
+		"azurerm_virtual_network_gateway":                    dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_connection":         dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network":                            dataSourceVirtualNetwork(),
+		"azurerm_virtual_network_free_address_ranges":        dataSourceVirtualNetworkFreeAddressRanges(),
+		"azurerm_web_application_firewall_policy":            dataWebApplicationFirewallPolicy(),
+		"azurerm_virtual_wan":                                dataSourceVirtualWan(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                 resourceApplicationGateway(),
-		"azurerm_application_security_group":          resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                        resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":    resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization": resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":       resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":               resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":            resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":               resourceExpressRouteGateway(),
-		"azurerm_express_route_port":                  resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                            resourceIpGroup(),
-		"azurerm_local_network_gateway":               resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                         resourceNatGateway(),
-		"azurerm_network_connection_monitor":          resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":        resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                   resourceNetworkInterface(),
+		"azurerm_application_gateway":                                                    resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool":                               resourceApplicationGatewayBackendAddressPool(),
+		"azurerm_application_gateway_http_listener":                                      resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_gateway_probe":                                              resourceApplicationGatewayProbe(),
+		"azurerm_application_gateway_request_routing_rule":                               resourceApplicationGatewayRequestRoutingRule(),
+		"azurerm_application_gateway_ssl_certificate":                                    resourceApplicationGatewaySslCertificate(),
+		"azurerm_application_security_group":                                             resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                                                           resourceBastionHost(),
+		"azurerm_custom_ip_prefix":                                                       resourceCustomIpPrefix(),
+		"azurerm_express_route_circuit_connection":                                       resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":                                    resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":                                          resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                                                  resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                                               resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                                                  resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                                                     resourceArmExpressRoutePort(),
+		"azurerm_ip_group":                                                               resourceIpGroup(),
+		"azurerm_local_network_gateway":                                                  resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                                                            resourceNatGateway(),
+		"azurerm_network_connection_monitor":                                             resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":                                           resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_dscp_configuration":                                             resourceNetworkDscpConfiguration(),
+		"azurerm_network_interface":                                                      resourceNetworkInterface(),
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
 		"azurerm_network_interface_backend_address_pool_association":                     resourceNetworkInterfaceBackendAddressPoolAssociation(),
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
+		"azurerm_network_interface_tap_configuration_association":                        resourceNetworkInterfaceTapConfigurationAssociation(),
 		"azurerm_network_packet_capture":                                                 resourceNetworkPacketCapture(),
 		"azurerm_network_profile":                                                        resourceNetworkProfile(),
 		"azurerm_packet_capture":                                                         resourcePacketCapture(),
@@ -86,9 +103,12 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_network_security_group":                                                 resourceNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                                                  resourceNetworkSecurityRule(),
 		"azurerm_network_watcher_flow_log":                                               resourceNetworkWatcherFlowLog(),
+		"azurerm_network_virtual_appliance":                                              resourceNetworkVirtualAppliance(),
 		"azurerm_network_watcher":                                                        resourceNetworkWatcher(),
 		"azurerm_route_filter":                                                           resourceRouteFilter(),
 		"azurerm_route_table":                                                            resourceRouteTable(),
+		"azurerm_route_server":                                                           resourceRouteServer(),
+		"azurerm_route_server_bgp_connection":                                            resourceRouteServerBgpConnection(),
 		"azurerm_route":                                                                  resourceRoute(),
 		"azurerm_virtual_hub_security_partner_provider":                                  resourceVirtualHubSecurityPartnerProvider(),
 		"azurerm_subnet_service_endpoint_storage_policy":                                 resourceSubnetServiceEndpointStoragePolicy(),
@@ -104,6 +124,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_gateway_connection":                                     resourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                                                resourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_peering":                                                resourceVirtualNetworkPeering(),
+		"azurerm_virtual_network_tap":                                                    resourceVirtualNetworkTap(),
 		"azurerm_virtual_network":                                                        resourceVirtualNetwork(),
 		"azurerm_virtual_wan":                                                            resourceVirtualWan(),
 		"azurerm_vpn_gateway":                                                            resourceVPNGateway(),
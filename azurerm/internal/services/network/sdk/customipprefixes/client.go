@@ -0,0 +1,15 @@
+package customipprefixes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CustomIPPrefixesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCustomIPPrefixesClientWithBaseURI(endpoint string) CustomIPPrefixesClient {
+	return CustomIPPrefixesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,21 @@
+package customipprefixes
+
+type CommissionedState string
+
+const (
+	CommissionedStateCommissioned    CommissionedState = "Commissioned"
+	CommissionedStateCommissioning   CommissionedState = "Commissioning"
+	CommissionedStateDecommissioning CommissionedState = "Decommissioning"
+	CommissionedStateDeprovisioning  CommissionedState = "Deprovisioning"
+	CommissionedStateProvisioned     CommissionedState = "Provisioned"
+	CommissionedStateProvisioning    CommissionedState = "Provisioning"
+)
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
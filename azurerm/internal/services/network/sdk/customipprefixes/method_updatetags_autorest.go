@@ -0,0 +1,65 @@
+package customipprefixes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *CustomIpPrefix
+}
+
+// UpdateTags ...
+func (c CustomIPPrefixesClient) UpdateTags(ctx context.Context, id CustomIpPrefixId, input TagsObject) (result UpdateTagsResponse, err error) {
+	req, err := c.preparerForUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customipprefixes.CustomIPPrefixesClient", "UpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customipprefixes.CustomIPPrefixesClient", "UpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customipprefixes.CustomIPPrefixesClient", "UpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUpdateTags prepares the UpdateTags request.
+func (c CustomIPPrefixesClient) preparerForUpdateTags(ctx context.Context, id CustomIpPrefixId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUpdateTags handles the response to the UpdateTags request. The method always
+// closes the http.Response Body.
+func (c CustomIPPrefixesClient) responderForUpdateTags(resp *http.Response) (result UpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,12 @@
+package customipprefixes
+
+type CustomIpPrefix struct {
+	Etag       *string                         `json:"etag,omitempty"`
+	Id         *string                         `json:"id,omitempty"`
+	Location   *string                         `json:"location,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *CustomIpPrefixPropertiesFormat `json:"properties,omitempty"`
+	Tags       *map[string]string              `json:"tags,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+	Zones      *[]string                       `json:"zones,omitempty"`
+}
@@ -0,0 +1,14 @@
+package customipprefixes
+
+type CustomIpPrefixPropertiesFormat struct {
+	AuthorizationMessage  *string            `json:"authorizationMessage,omitempty"`
+	ChildCustomIpPrefixes *[]SubResource     `json:"childCustomIpPrefixes,omitempty"`
+	Cidr                  *string            `json:"cidr,omitempty"`
+	CommissionedState     *CommissionedState `json:"commissionedState,omitempty"`
+	CustomIpPrefixParent  *SubResource       `json:"customIpPrefixParent,omitempty"`
+	FailedReason          *string            `json:"failedReason,omitempty"`
+	ProvisioningState     *ProvisioningState `json:"provisioningState,omitempty"`
+	PublicIpPrefixes      *[]SubResource     `json:"publicIpPrefixes,omitempty"`
+	ResourceGuid          *string            `json:"resourceGuid,omitempty"`
+	SignedMessage         *string            `json:"signedMessage,omitempty"`
+}
@@ -0,0 +1,5 @@
+package customipprefixes
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,28 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+)
+
+func IsRouteServerSubnet(i interface{}, k string) (warnings []string, errors []error) {
+	value, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	id, err := parse.SubnetIDInsensitively(value)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if !strings.EqualFold(id.Name, "RouteServerSubnet") {
+		errors = append(errors, fmt.Errorf("expected %s to reference a subnet with name RouteServerSubnet", k))
+	}
+
+	return warnings, errors
+}
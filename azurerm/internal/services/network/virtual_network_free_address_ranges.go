@@ -0,0 +1,41 @@
+package network
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// findFreeVirtualNetworkAddressRanges returns up to `numberOfRanges` address ranges with the specified prefix length which
+// fall within the address spaces but don't overlap any of the used ranges - where `isAvailable` is used to confirm that
+// each candidate range can be used, with unavailable ranges being skipped in favour of the next free range
+func findFreeVirtualNetworkAddressRanges(addressSpaces, usedRanges []string, prefixLength, numberOfRanges int, isAvailable func(cidr string) (bool, error)) ([]string, error) {
+	// copy the used ranges so that the candidates we add below don't modify the input
+	used := make([]string, 0, len(usedRanges))
+	used = append(used, usedRanges...)
+
+	addressPrefixes := make([]string, 0)
+	for len(addressPrefixes) < numberOfRanges {
+		candidates, err := validate.FreeIPv4CIDRs(addressSpaces, used, prefixLength, numberOfRanges-len(addressPrefixes))
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		for _, candidate := range candidates {
+			// every candidate is treated as used, so that the next iteration doesn't return it again
+			used = append(used, candidate)
+
+			available, err := isAvailable(candidate)
+			if err != nil {
+				return nil, err
+			}
+
+			if available {
+				addressPrefixes = append(addressPrefixes, candidate)
+			}
+		}
+	}
+
+	return addressPrefixes, nil
+}
@@ -0,0 +1,161 @@
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVirtualNetworkFreeAddressRanges() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkFreeAddressRangesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: networkValidate.VirtualNetworkID,
+			},
+
+			"prefix_length": {
+				Type:     pluginsdk.TypeInt,
+				Required: true,
+				// the smallest Subnet supported by Azure is a /29
+				ValidateFunc: validation.IntBetween(1, 29),
+			},
+
+			"number_of_ranges": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"check_ip_address_availability": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkFreeAddressRangesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkID(d.Get("virtual_network_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	addressSpaces := make([]string, 0)
+	usedRanges := make([]string, 0)
+	if props := resp.VirtualNetworkPropertiesFormat; props != nil {
+		if props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+			addressSpaces = *props.AddressSpace.AddressPrefixes
+		}
+
+		usedRanges = virtualNetworkSubnetAddressPrefixes(props.Subnets)
+	}
+
+	prefixLength := d.Get("prefix_length").(int)
+	numberOfRanges := d.Get("number_of_ranges").(int)
+	checkAvailability := d.Get("check_ip_address_availability").(bool)
+
+	addressPrefixes, err := findFreeVirtualNetworkAddressRanges(addressSpaces, usedRanges, prefixLength, numberOfRanges, func(cidr string) (bool, error) {
+		if !checkAvailability {
+			return true, nil
+		}
+
+		return checkVirtualNetworkAddressRangeAvailability(ctx, client, *id, cidr)
+	})
+	if err != nil {
+		return fmt.Errorf("determining the free address ranges within %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("virtual_network_id", id.ID())
+	if err := d.Set("address_prefixes", addressPrefixes); err != nil {
+		return fmt.Errorf("setting `address_prefixes`: %+v", err)
+	}
+
+	return nil
+}
+
+func virtualNetworkSubnetAddressPrefixes(input *[]network.Subnet) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, subnet := range *input {
+		props := subnet.SubnetPropertiesFormat
+		if props == nil {
+			continue
+		}
+
+		if props.AddressPrefix != nil && *props.AddressPrefix != "" {
+			results = append(results, *props.AddressPrefix)
+		}
+
+		if props.AddressPrefixes != nil {
+			results = append(results, *props.AddressPrefixes...)
+		}
+	}
+
+	return results
+}
+
+// checkVirtualNetworkAddressRangeAvailability confirms that the first usable IP Address within the address range
+// is available - since Azure reserves the first four IP Addresses within each Subnet
+func checkVirtualNetworkAddressRangeAvailability(ctx context.Context, client *network.VirtualNetworksClient, id parse.VirtualNetworkId, cidr string) (bool, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, fmt.Errorf("parsing %q as a CIDR: %+v", cidr, err)
+	}
+
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(ipNet.IP.To4())+4)
+
+	resp, err := client.CheckIPAddressAvailability(ctx, id.ResourceGroup, id.Name, ip.String())
+	if err != nil {
+		return false, fmt.Errorf("checking the availability of IP Address %q within %s: %+v", ip.String(), id, err)
+	}
+
+	return resp.Available != nil && *resp.Available, nil
+}
@@ -0,0 +1,105 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkFreeAddressRangesDataSource struct {
+}
+
+func TestAccDataSourceVirtualNetworkFreeAddressRanges_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_free_address_ranges", "test")
+	r := VirtualNetworkFreeAddressRangesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.1.0/24"),
+				check.That(data.ResourceName).Key("address_prefixes.1").HasValue("10.0.3.0/24"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceVirtualNetworkFreeAddressRanges_checkIPAddressAvailability(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_free_address_ranges", "test")
+	r := VirtualNetworkFreeAddressRangesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.checkIPAddressAvailability(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.0.128/25"),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkFreeAddressRangesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_free_address_ranges" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  prefix_length      = 24
+  number_of_ranges   = 2
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, VirtualNetworkFreeAddressRangesDataSource{}.template(data))
+}
+
+func (VirtualNetworkFreeAddressRangesDataSource) checkIPAddressAvailability(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_network_free_address_ranges" "test" {
+  virtual_network_id            = azurerm_virtual_network.test.id
+  prefix_length                 = 25
+  check_ip_address_availability = true
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, VirtualNetworkFreeAddressRangesDataSource{}.template(data))
+}
+
+func (VirtualNetworkFreeAddressRangesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/25"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
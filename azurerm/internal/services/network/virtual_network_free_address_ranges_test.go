@@ -0,0 +1,93 @@
+package network
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFindFreeVirtualNetworkAddressRanges(t *testing.T) {
+	cases := []struct {
+		Name           string
+		AddressSpaces  []string
+		UsedRanges     []string
+		PrefixLength   int
+		NumberOfRanges int
+		Unavailable    []string
+		Expected       []string
+		ExpectError    bool
+	}{
+		{
+			Name:           "all available",
+			AddressSpaces:  []string{"10.0.0.0/16"},
+			UsedRanges:     []string{"10.0.0.0/24"},
+			PrefixLength:   24,
+			NumberOfRanges: 3,
+			Expected:       []string{"10.0.1.0/24", "10.0.2.0/24", "10.0.3.0/24"},
+		},
+		{
+			Name:           "mixed available and unavailable",
+			AddressSpaces:  []string{"10.0.0.0/16"},
+			UsedRanges:     []string{"10.0.0.0/24"},
+			PrefixLength:   24,
+			NumberOfRanges: 3,
+			Unavailable:    []string{"10.0.2.0/24"},
+			Expected:       []string{"10.0.1.0/24", "10.0.3.0/24", "10.0.4.0/24"},
+		},
+		{
+			Name:           "multiple unavailable across iterations",
+			AddressSpaces:  []string{"10.0.0.0/16"},
+			PrefixLength:   24,
+			NumberOfRanges: 2,
+			Unavailable:    []string{"10.0.0.0/24", "10.0.2.0/24", "10.0.3.0/24"},
+			Expected:       []string{"10.0.1.0/24", "10.0.4.0/24"},
+		},
+		{
+			Name:           "exhausted address space",
+			AddressSpaces:  []string{"10.0.0.0/23"},
+			PrefixLength:   24,
+			NumberOfRanges: 2,
+			Unavailable:    []string{"10.0.0.0/24"},
+			Expected:       []string{"10.0.1.0/24"},
+		},
+		{
+			Name:           "availability check fails",
+			AddressSpaces:  []string{"10.0.0.0/16"},
+			PrefixLength:   24,
+			NumberOfRanges: 1,
+			Unavailable:    []string{"error"},
+			ExpectError:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			unavailable := make(map[string]bool)
+			for _, v := range tc.Unavailable {
+				unavailable[v] = true
+			}
+
+			actual, err := findFreeVirtualNetworkAddressRanges(tc.AddressSpaces, tc.UsedRanges, tc.PrefixLength, tc.NumberOfRanges, func(cidr string) (bool, error) {
+				if unavailable["error"] {
+					return false, fmt.Errorf("checking the availability of %q", cidr)
+				}
+
+				return !unavailable[cidr], nil
+			})
+			if err != nil {
+				if tc.ExpectError {
+					return
+				}
+
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error but didn't get one")
+			}
+
+			if !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+			}
+		})
+	}
+}
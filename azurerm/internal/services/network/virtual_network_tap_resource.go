@@ -0,0 +1,219 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const virtualNetworkTapResourceName = "azurerm_virtual_network_tap"
+
+func resourceVirtualNetworkTap() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualNetworkTapCreateUpdate,
+		Read:   resourceVirtualNetworkTapRead,
+		Update: resourceVirtualNetworkTapCreateUpdate,
+		Delete: resourceVirtualNetworkTapDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualNetworkTapID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"destination_network_interface_ip_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				ExactlyOneOf: []string{
+					"destination_network_interface_ip_configuration_id",
+					"destination_load_balancer_frontend_ip_configuration_id",
+				},
+			},
+
+			"destination_load_balancer_frontend_ip_configuration_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				ExactlyOneOf: []string{
+					"destination_network_interface_ip_configuration_id",
+					"destination_load_balancer_frontend_ip_configuration_id",
+				},
+			},
+
+			"destination_port": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      4789,
+				ValidateFunc: validation.IsPortNumber,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualNetworkTapCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetTapsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualNetworkTapID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_network_tap", id.ID())
+		}
+	}
+
+	locks.ByName(id.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkTapResourceName)
+
+	parameters := network.VirtualNetworkTap{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualNetworkTapPropertiesFormat: &network.VirtualNetworkTapPropertiesFormat{
+			DestinationPort: utils.Int32(int32(d.Get("destination_port").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("destination_network_interface_ip_configuration_id"); ok {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationNetworkInterfaceIPConfiguration = &network.InterfaceIPConfiguration{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("destination_load_balancer_frontend_ip_configuration_id"); ok {
+		parameters.VirtualNetworkTapPropertiesFormat.DestinationLoadBalancerFrontEndIPConfiguration = &network.FrontendIPConfiguration{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualNetworkTapRead(d, meta)
+}
+
+func resourceVirtualNetworkTapRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetTapsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	if props := resp.VirtualNetworkTapPropertiesFormat; props != nil {
+		nicIpConfigurationId := ""
+		if props.DestinationNetworkInterfaceIPConfiguration != nil && props.DestinationNetworkInterfaceIPConfiguration.ID != nil {
+			nicIpConfigurationId = *props.DestinationNetworkInterfaceIPConfiguration.ID
+		}
+		d.Set("destination_network_interface_ip_configuration_id", nicIpConfigurationId)
+
+		frontendIpConfigurationId := ""
+		if props.DestinationLoadBalancerFrontEndIPConfiguration != nil && props.DestinationLoadBalancerFrontEndIPConfiguration.ID != nil {
+			frontendIpConfigurationId = *props.DestinationLoadBalancerFrontEndIPConfiguration.ID
+		}
+		d.Set("destination_load_balancer_frontend_ip_configuration_id", frontendIpConfigurationId)
+
+		destinationPort := 0
+		if props.DestinationPort != nil {
+			destinationPort = int(*props.DestinationPort)
+		}
+		d.Set("destination_port", destinationPort)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualNetworkTapDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetTapsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualNetworkTapID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, virtualNetworkTapResourceName)
+	defer locks.UnlockByName(id.Name, virtualNetworkTapResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
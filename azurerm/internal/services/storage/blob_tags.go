@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+// NOTE: the version of the Storage SDK in use doesn't expose the Get/Set Blob Tags APIs, as such
+// this is a minimal implementation using the Blobs Client, which can be removed once the SDK supports it
+
+type blobTags struct {
+	XMLName xml.Name   `xml:"Tags"`
+	TagSet  blobTagSet `xml:"TagSet"`
+}
+
+type blobTagSet struct {
+	Tags []blobTag `xml:"Tag"`
+}
+
+type blobTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// getStorageBlobIndexTags returns the Index Tags assigned to the specified Blob
+func getStorageBlobIndexTags(ctx context.Context, client *blobs.Client, accountName, containerName, blobName string) (tags map[string]string, resp autorest.Response, err error) {
+	req, err := storageBlobIndexTagsPreparer(ctx, client, accountName, containerName, blobName, autorest.AsGet())
+	if err != nil {
+		return nil, resp, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", nil, "Failure preparing request")
+	}
+
+	httpResp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		resp = autorest.Response{Response: httpResp}
+		return nil, resp, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", httpResp, "Failure sending request")
+	}
+
+	var result blobTags
+	err = autorest.Respond(
+		httpResp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	resp = autorest.Response{Response: httpResp}
+	if err != nil {
+		return nil, resp, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", httpResp, "Failure responding to request")
+	}
+
+	tags = make(map[string]string)
+	for _, v := range result.TagSet.Tags {
+		tags[v.Key] = v.Value
+	}
+
+	return tags, resp, nil
+}
+
+// setStorageBlobIndexTags replaces the Index Tags assigned to the specified Blob with `tags`
+func setStorageBlobIndexTags(ctx context.Context, client *blobs.Client, accountName, containerName, blobName string, tags map[string]string) (resp autorest.Response, err error) {
+	payload := blobTags{
+		TagSet: blobTagSet{
+			Tags: make([]blobTag, 0),
+		},
+	}
+	for k, v := range tags {
+		payload.TagSet.Tags = append(payload.TagSet.Tags, blobTag{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	req, err := storageBlobIndexTagsPreparer(ctx, client, accountName, containerName, blobName, autorest.AsPut(), autorest.WithXML(payload))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "blobs.Client", "SetTags", nil, "Failure preparing request")
+	}
+
+	httpResp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		resp = autorest.Response{Response: httpResp}
+		return resp, autorest.NewErrorWithError(err, "blobs.Client", "SetTags", httpResp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		httpResp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	resp = autorest.Response{Response: httpResp}
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "blobs.Client", "SetTags", httpResp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+func storageBlobIndexTagsPreparer(ctx context.Context, client *blobs.Client, accountName, containerName, blobName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+		"blobName":      autorest.Encode("path", blobName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "tags"),
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": blobs.APIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", accountName, client.BaseURI)),
+		autorest.WithPathParameters("/{containerName}/{blobName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
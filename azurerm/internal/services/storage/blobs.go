@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -150,15 +151,47 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// the source file is read twice: firstly to calculate the MD5 of the file and each block (which both verifies the
+	// file before anything's uploaded, and allows the blocks staged by an interrupted upload to be re-used) - and then
+	// secondly to upload each block, which avoids needing to hold the whole file in memory
+	blockList, contentMD5, err := sbu.storageBlobBlockSplit(file, info.Size())
+	if err != nil {
+		return fmt.Errorf("Error splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	// verify the file on disk before uploading anything, rather than after it's been committed
+	if sbu.ContentMD5 != "" && sbu.ContentMD5 != contentMD5 {
+		return fmt.Errorf("the MD5 of the source file %q (%q) doesn't match the `content_md5` (%q)", sbu.Source, contentMD5, sbu.ContentMD5)
+	}
+
+	if err := sbu.blockUploadFromSource(ctx, blockList); err != nil {
+		return fmt.Errorf("Error uploading source file %q: %s", sbu.Source, err)
+	}
+
+	blockIds := make([]blobs.BlockID, 0, len(blockList))
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: block.id,
+		})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		// unlike when uploading a Blob in a single request, Azure doesn't calculate the MD5 of a Blob made up of
+		// Blocks - so this is set to the MD5 of the source file (which matches the `content_md5`, if specified)
+		ContentMD5:  utils.String(contentMD5),
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
 	}
-	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
-	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
 	}
 
 	return nil
@@ -363,6 +396,176 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+type storageBlobBlock struct {
+	id         string
+	contentMD5 string
+	section    *io.SectionReader
+}
+
+const (
+	// the maximum number of blocks which can make up a single Block blob
+	maxBlockCount int64 = 50000
+
+	minBlockSize int64 = 4 * 1024 * 1024
+
+	// the maximum amount of memory which can be used to buffer Blocks across all of the workers uploading them
+	maxBlockUploadBufferSize int64 = 256 * 1024 * 1024
+)
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, blockList []storageBlobBlock) error {
+	if len(blockList) == 0 {
+		return nil
+	}
+
+	// each worker buffers a single Block at a time, so the number of workers is limited such that the Blocks
+	// being uploaded at any one time fit within the buffer size
+	blockSize := blockList[0].section.Size()
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+	if maxWorkerCount := int(maxBlockUploadBufferSize / blockSize); workerCount > maxWorkerCount {
+		workerCount = maxWorkerCount
+	}
+	if workerCount > len(blockList) {
+		workerCount = len(blockList)
+	}
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	// blocks staged by a previous (interrupted) upload of this same file can be committed as-is
+	stagedBlocks, err := sbu.stagedBlockIds(ctx)
+	if err != nil {
+		return err
+	}
+
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+
+	for _, block := range blockList {
+		if _, ok := stagedBlocks[block.id]; ok {
+			continue
+		}
+
+		wg.Add(1)
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blockSize: blockSize,
+			blocks:    blocks,
+			errors:    errors,
+			wg:        wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return <-errors
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) stagedBlockIds(ctx context.Context) (map[string]struct{}, error) {
+	stagedBlocks := make(map[string]struct{})
+
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	}
+	resp, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		// there's nothing staged for a Blob which doesn't exist yet
+		if utils.ResponseWasNotFound(resp.Response) {
+			return stagedBlocks, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving the Uncommitted Blocks: %s", err)
+	}
+
+	for _, block := range resp.UncommittedBlocks.Blocks {
+		stagedBlocks[block.Name] = struct{}{}
+	}
+
+	return stagedBlocks, nil
+}
+
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64) ([]storageBlobBlock, string, error) {
+	blockSize := minBlockSize
+	if fileSize > blockSize*maxBlockCount {
+		blockSize = fileSize / maxBlockCount
+		if fileSize%maxBlockCount != 0 {
+			blockSize++
+		}
+	}
+
+	// the Block ID is derived from both the position and the contents of the block, such that any blocks
+	// which were staged prior to an interrupted upload are only re-used when their contents still match
+	fileHash := md5.New()
+	blocks := make([]storageBlobBlock, 0)
+	for offset := int64(0); offset < fileSize; offset += blockSize {
+		section := io.NewSectionReader(file, offset, blockSize)
+
+		blockHash := md5.New()
+		if _, err := io.Copy(io.MultiWriter(fileHash, blockHash), section); err != nil {
+			return nil, "", fmt.Errorf("Could not read block at %d: %s", offset, err)
+		}
+		blockMD5 := blockHash.Sum(nil)
+
+		blockId := fmt.Sprintf("%05d-%s", len(blocks), hex.EncodeToString(blockMD5))
+		blocks = append(blocks, storageBlobBlock{
+			id:         base64.StdEncoding.EncodeToString([]byte(blockId)),
+			contentMD5: base64.StdEncoding.EncodeToString(blockMD5),
+			section:    io.NewSectionReader(file, offset, section.Size()),
+		})
+	}
+
+	return blocks, base64.StdEncoding.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+type blobBlockUploadContext struct {
+	blockSize int64
+	blocks    chan storageBlobBlock
+	errors    chan error
+	wg        *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	// the buffer is re-used for each Block uploaded by this worker, since all but the last Block are the same size
+	buffer := make([]byte, uploadCtx.blockSize)
+	for block := range uploadCtx.blocks {
+		chunk := buffer[:block.section.Size()]
+		if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+			uploadCtx.errors <- fmt.Errorf("Error reading source file %q for block %q: %s", sbu.Source, block.id, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+
+		resp, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err != nil {
+			uploadCtx.errors <- fmt.Errorf("Error writing block %q for file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		// the service returns the MD5 of the block it received, so that it can be verified
+		if resp.ContentMD5 != "" && resp.ContentMD5 != block.contentMD5 {
+			uploadCtx.errors <- fmt.Errorf("the MD5 of block %q for file %q (%q) doesn't match the MD5 received by Azure (%q)", block.id, sbu.Source, block.contentMD5, resp.ContentMD5)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
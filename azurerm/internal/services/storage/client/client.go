@@ -9,6 +9,8 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/localusers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/storageaccounts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -24,20 +26,24 @@ import (
 )
 
 type Client struct {
-	AccountsClient              *storage.AccountsClient
-	FileSystemsClient           *filesystems.Client
-	ADLSGen2PathsClient         *paths.Client
-	ManagementPoliciesClient    *storage.ManagementPoliciesClient
-	BlobServicesClient          *storage.BlobServicesClient
-	BlobInventoryPoliciesClient *storage.BlobInventoryPoliciesClient
-	CloudEndpointsClient        *storagesync.CloudEndpointsClient
-	EncryptionScopesClient      *storage.EncryptionScopesClient
-	Environment                 az.Environment
-	FileServicesClient          *storage.FileServicesClient
-	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
-	SyncServiceClient           *storagesync.ServicesClient
-	SyncGroupsClient            *storagesync.SyncGroupsClient
-	SubscriptionId              string
+	AccountsClient                  *storage.AccountsClient
+	BlobContainersClient            *storage.BlobContainersClient
+	FileSystemsClient               *filesystems.Client
+	ADLSGen2PathsClient             *paths.Client
+	ManagementPoliciesClient        *storage.ManagementPoliciesClient
+	BlobServicesClient              *storage.BlobServicesClient
+	BlobInventoryPoliciesClient     *storage.BlobInventoryPoliciesClient
+	CloudEndpointsClient            *storagesync.CloudEndpointsClient
+	EncryptionScopesClient          *storage.EncryptionScopesClient
+	Environment                     az.Environment
+	FileServicesClient              *storage.FileServicesClient
+	FileSharesResourceManagerClient *storage.FileSharesClient
+	LocalUsersClient                *localusers.LocalUsersClient
+	ObjectReplicationClient         *storage.ObjectReplicationPoliciesClient
+	StorageAccountsClient           *storageaccounts.StorageAccountsClient
+	SyncServiceClient               *storagesync.ServicesClient
+	SyncGroupsClient                *storagesync.SyncGroupsClient
+	SubscriptionId                  string
 
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
@@ -47,6 +53,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := storage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	fileSystemsClient := filesystems.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&fileSystemsClient.Client, options.StorageAuthorizer)
 
@@ -71,9 +80,18 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	fileSharesResourceManagerClient := storage.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&fileSharesResourceManagerClient.Client, options.ResourceManagerAuthorizer)
+
+	localUsersClient := localusers.NewLocalUsersClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&localUsersClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	storageAccountsClient := storageaccounts.NewStorageAccountsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&storageAccountsClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -83,20 +101,24 @@ func NewClient(options *common.ClientOptions) *Client {
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
-		AccountsClient:              &accountsClient,
-		FileSystemsClient:           &fileSystemsClient,
-		ADLSGen2PathsClient:         &adlsGen2PathsClient,
-		ManagementPoliciesClient:    &managementPoliciesClient,
-		BlobServicesClient:          &blobServicesClient,
-		BlobInventoryPoliciesClient: &blobInventoryPoliciesClient,
-		CloudEndpointsClient:        &cloudEndpointsClient,
-		EncryptionScopesClient:      &encryptionScopesClient,
-		Environment:                 options.Environment,
-		FileServicesClient:          &fileServicesClient,
-		ObjectReplicationClient:     &objectReplicationPolicyClient,
-		SubscriptionId:              options.SubscriptionId,
-		SyncServiceClient:           &syncServiceClient,
-		SyncGroupsClient:            &syncGroupsClient,
+		AccountsClient:                  &accountsClient,
+		BlobContainersClient:            &blobContainersClient,
+		FileSystemsClient:               &fileSystemsClient,
+		ADLSGen2PathsClient:             &adlsGen2PathsClient,
+		ManagementPoliciesClient:        &managementPoliciesClient,
+		BlobServicesClient:              &blobServicesClient,
+		BlobInventoryPoliciesClient:     &blobInventoryPoliciesClient,
+		CloudEndpointsClient:            &cloudEndpointsClient,
+		EncryptionScopesClient:          &encryptionScopesClient,
+		Environment:                     options.Environment,
+		FileServicesClient:              &fileServicesClient,
+		FileSharesResourceManagerClient: &fileSharesResourceManagerClient,
+		LocalUsersClient:                &localUsersClient,
+		ObjectReplicationClient:         &objectReplicationPolicyClient,
+		StorageAccountsClient:           &storageAccountsClient,
+		SubscriptionId:                  options.SubscriptionId,
+		SyncServiceClient:               &syncServiceClient,
+		SyncGroupsClient:                &syncGroupsClient,
 
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
@@ -115,14 +137,9 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 		return &accountsClient, nil
 	}
 
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKey, "Blob")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
@@ -137,14 +154,9 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 		return &blobsClient, nil
 	}
 
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKey, "Blob")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
@@ -160,14 +172,9 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 		return shim, nil
 	}
 
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKey, "Blob")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
@@ -177,17 +184,30 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 	return shim, nil
 }
 
-func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
-	// NOTE: Files do not support AzureAD Authentication
+// ContainersDataPlaneClient returns the Data Plane client for Storage Containers, which (unlike
+// ContainersClient) is needed to list the Blobs within a Storage Container
+func (client Client) ContainersDataPlaneClient(ctx context.Context, account accountDetails) (*containers.Client, error) {
+	if client.storageAdAuth != nil {
+		containersClient := containers.NewWithEnvironment(client.Environment)
+		containersClient.Client.Authorizer = *client.storageAdAuth
+		return &containersClient, nil
+	}
 
-	accountKey, err := account.AccountKey(ctx, client)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKey, "Blob")
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
+		return nil, err
 	}
 
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLite)
+	containersClient := containers.NewWithEnvironment(client.Environment)
+	containersClient.Client.Authorizer = storageAuth
+	return &containersClient, nil
+}
+
+func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
+	// NOTE: the version of the File API in use doesn't support AzureAD Authentication, so this always uses a Shared Key
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLite, "File")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
@@ -196,16 +216,10 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 }
 
 func (client Client) FileShareFilesClient(ctx context.Context, account accountDetails) (*files.Client, error) {
-	// NOTE: Files do not support AzureAD Authentication
-
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLite)
+	// NOTE: the version of the File API in use doesn't support AzureAD Authentication, so this always uses a Shared Key
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLite, "File")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	filesClient := files.NewWithEnvironment(client.Environment)
@@ -214,16 +228,10 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 }
 
 func (client Client) FileSharesClient(ctx context.Context, account accountDetails) (shim.StorageShareWrapper, error) {
-	// NOTE: Files do not support AzureAD Authentication
-
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLite)
+	// NOTE: the version of the File API in use doesn't support AzureAD Authentication, so this always uses a Shared Key
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLite, "File")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
@@ -239,14 +247,9 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("retrieving Account Key: %s", err)
-	}
-
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLite)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLite, "Queue")
 	if err != nil {
-		return nil, fmt.Errorf("building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
@@ -255,16 +258,15 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 }
 
 func (client Client) TableEntityClient(ctx context.Context, account accountDetails) (*entities.Client, error) {
-	// NOTE: Table Entity does not support AzureAD Authentication
-
-	accountKey, err := account.AccountKey(ctx, client)
-	if err != nil {
-		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
+	if client.storageAdAuth != nil {
+		entitiesClient := entities.NewWithEnvironment(client.Environment)
+		entitiesClient.Client.Authorizer = *client.storageAdAuth
+		return &entitiesClient, nil
 	}
 
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLiteForTable, "Table")
 	if err != nil {
-		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
+		return nil, err
 	}
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
@@ -273,20 +275,51 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 }
 
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
-	// NOTE: Tables do not support AzureAD Authentication
+	if client.storageAdAuth != nil {
+		tablesClient := tables.NewWithEnvironment(client.Environment)
+		tablesClient.Client.Authorizer = *client.storageAdAuth
+		shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
+		return shim, nil
+	}
+
+	storageAuth, err := client.sharedKeyAuthorizer(ctx, account, autorest.SharedKeyLiteForTable, "Table")
+	if err != nil {
+		return nil, err
+	}
+
+	tablesClient := tables.NewWithEnvironment(client.Environment)
+	tablesClient.Client.Authorizer = storageAuth
+	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
+	return shim, nil
+}
+
+// DataPlaneAccessAvailable returns whether the Data Plane APIs which support AzureAD Authentication (e.g. Blob and
+// Queue) can be used for the specified Storage Account - which requires either AzureAD Authentication to be enabled in
+// the Provider block or Shared Key Access to be enabled on the Storage Account
+func (client Client) DataPlaneAccessAvailable(account accountDetails) bool {
+	return client.storageAdAuth != nil || account.SharedKeyAccessEnabled()
+}
+
+// sharedKeyAuthorizer returns an Authorizer using the Access Key of the specified Storage Account, which is used
+// when AzureAD Authentication isn't enabled in the Provider block or isn't supported by the `service` Data Plane API
+func (client Client) sharedKeyAuthorizer(ctx context.Context, account accountDetails, keyType autorest.SharedKeyType, service string) (autorest.Authorizer, error) {
+	if !account.SharedKeyAccessEnabled() {
+		if client.storageAdAuth != nil {
+			return nil, fmt.Errorf("the %s Data Plane API doesn't support AzureAD Authentication and Shared Key Access is disabled for Storage Account %q - `shared_access_key_enabled` must be enabled on the Storage Account to manage this resource", service, account.name)
+		}
+
+		return nil, fmt.Errorf("Shared Key Access is disabled for Storage Account %q - either `shared_access_key_enabled` must be enabled on the Storage Account or `storage_use_azuread` must be enabled in the Provider block to use the %s Data Plane API", account.name, service)
+	}
 
 	accountKey, err := account.AccountKey(ctx, client)
 	if err != nil {
 		return nil, fmt.Errorf("Error retrieving Account Key: %s", err)
 	}
 
-	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, keyType)
 	if err != nil {
 		return nil, fmt.Errorf("Error building Authorizer: %+v", err)
 	}
 
-	tablesClient := tables.NewWithEnvironment(client.Environment)
-	tablesClient.Client.Authorizer = storageAuth
-	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
-	return shim, nil
+	return storageAuth, nil
 }
@@ -20,6 +20,8 @@ var (
 type accountDetails struct {
 	ID            string
 	ResourceGroup string
+	Kind          storage.Kind
+	Sku           *storage.Sku
 	Properties    *storage.AccountProperties
 
 	accountKey *string
@@ -53,6 +55,30 @@ func (ad *accountDetails) AccountKey(ctx context.Context, client Client) (*strin
 	return ad.accountKey, nil
 }
 
+// SharedKeyAccessEnabled returns whether the Data Plane API's can be accessed using the Access Key for this
+// Storage Account - which the API defaults to when this hasn't been explicitly configured
+func (ad *accountDetails) SharedKeyAccessEnabled() bool {
+	if ad.Properties == nil || ad.Properties.AllowSharedKeyAccess == nil {
+		return true
+	}
+
+	return *ad.Properties.AllowSharedKeyAccess
+}
+
+// BlobIndexTagsSupported returns whether Blob Index Tags can be used within this Storage Account - which is only
+// the case for Standard General Purpose v2 and Blob Storage accounts without a Hierarchical Namespace (Data Lake Gen2)
+func (ad *accountDetails) BlobIndexTagsSupported() bool {
+	if ad.Kind != storage.StorageV2 && ad.Kind != storage.BlobStorage {
+		return false
+	}
+
+	if ad.Sku != nil && ad.Sku.Tier == storage.Premium {
+		return false
+	}
+
+	return ad.Properties == nil || ad.Properties.IsHnsEnabled == nil || !*ad.Properties.IsHnsEnabled
+}
+
 func (client Client) AddToCache(accountName string, props storage.Account) error {
 	accountsLock.Lock()
 	defer accountsLock.Unlock()
@@ -130,6 +156,8 @@ func populateAccountDetails(accountName string, props storage.Account) (*account
 		name:          accountName,
 		ID:            accountId,
 		ResourceGroup: id.ResourceGroup,
+		Kind:          props.Kind,
+		Sku:           props.Sku,
 		Properties:    props.AccountProperties,
 	}, nil
 }
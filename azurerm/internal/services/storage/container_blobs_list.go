@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+// NOTE: the version of the Storage SDK in use only parses the first Blob Prefix and doesn't parse the MetaData returned
+// when listing Blobs, as such this is a minimal implementation using the Containers Client which parses both, which can
+// be removed once the SDK supports it
+
+type listContainerBlobsInput struct {
+	Delimiter       *string
+	Marker          *string
+	Prefix          *string
+	IncludeMetaData bool
+}
+
+type listContainerBlobsResult struct {
+	autorest.Response
+
+	NextMarker *string                `xml:"NextMarker,omitempty"`
+	Blobs      []containerBlobDetails `xml:"Blobs>Blob"`
+	Prefixes   []containerBlobPrefix  `xml:"Blobs>BlobPrefix"`
+}
+
+type containerBlobDetails struct {
+	Name       string                  `xml:"Name"`
+	MetaData   containerBlobMetaData   `xml:"Metadata"`
+	Properties containerBlobProperties `xml:"Properties"`
+}
+
+type containerBlobProperties struct {
+	AccessTier    *string `xml:"AccessTier,omitempty"`
+	BlobType      *string `xml:"BlobType,omitempty"`
+	ContentLength *int64  `xml:"Content-Length,omitempty"`
+	ContentMD5    *string `xml:"Content-MD5,omitempty"`
+	ContentType   *string `xml:"Content-Type,omitempty"`
+	LastModified  *string `xml:"Last-Modified,omitempty"`
+}
+
+type containerBlobPrefix struct {
+	Name string `xml:"Name"`
+}
+
+// containerBlobMetaData is the MetaData of a Blob, which is returned as an element per MetaData key
+type containerBlobMetaData map[string]string
+
+func (m *containerBlobMetaData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*m = make(map[string]string)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &t); err != nil {
+				return err
+			}
+			(*m)[t.Name.Local] = value
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// listContainerBlobs returns a single page of the Blobs (and the Blob Prefixes when a Delimiter is specified) within the Container
+func listContainerBlobs(ctx context.Context, client *containers.Client, accountName, containerName string, input listContainerBlobsInput) (result listContainerBlobsResult, err error) {
+	req, err := listContainerBlobsPreparer(ctx, client, accountName, containerName, input)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containers.Client", "ListBlobs", nil, "Failure preparing request")
+	}
+
+	httpResp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: httpResp}
+		return result, autorest.NewErrorWithError(err, "containers.Client", "ListBlobs", httpResp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		httpResp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: httpResp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "containers.Client", "ListBlobs", httpResp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func listContainerBlobsPreparer(ctx context.Context, client *containers.Client, accountName, containerName string, input listContainerBlobsInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", containerName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp":    autorest.Encode("query", "list"),
+		"restype": autorest.Encode("query", "container"),
+	}
+	if input.Delimiter != nil {
+		queryParameters["delimiter"] = autorest.Encode("query", *input.Delimiter)
+	}
+	if input.IncludeMetaData {
+		queryParameters["include"] = autorest.Encode("query", string(containers.MetaData))
+	}
+	if input.Marker != nil {
+		queryParameters["marker"] = autorest.Encode("query", *input.Marker)
+	}
+	if input.Prefix != nil {
+		queryParameters["prefix"] = autorest.Encode("query", *input.Prefix)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": containers.APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", accountName, client.BaseURI)),
+		autorest.WithPathParameters("/{containerName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
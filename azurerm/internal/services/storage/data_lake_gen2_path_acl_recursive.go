@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/datalakestore/paths"
+)
+
+// NOTE: the version of the Storage SDK in use doesn't expose the Set Access Control Recursive API, as such
+// this is a minimal implementation using the Paths Client, which can be removed once the SDK supports it
+
+// setAccessControlRecursiveAPIVersion is the first API Version which supports setting Access Control recursively
+const setAccessControlRecursiveAPIVersion = "2020-02-10"
+
+type setAccessControlRecursiveMode string
+
+const (
+	setAccessControlRecursiveModeModify setAccessControlRecursiveMode = "modify"
+	setAccessControlRecursiveModeSet    setAccessControlRecursiveMode = "set"
+)
+
+type setAccessControlRecursiveInput struct {
+	Mode setAccessControlRecursiveMode
+	ACL  string
+
+	// the Continuation Token returned from a previous batch, if any
+	Continuation *string
+
+	// whether the operation should continue when it fails to update the ACL of a child Path
+	ForceFlag bool
+
+	// the maximum number of Paths which should be updated in a single batch
+	MaxRecords int
+}
+
+type setAccessControlRecursiveResult struct {
+	autorest.Response
+
+	Continuation string
+
+	DirectoriesSuccessful int                                    `json:"directoriesSuccessful"`
+	FilesSuccessful       int                                    `json:"filesSuccessful"`
+	FailureCount          int                                    `json:"failureCount"`
+	FailedEntries         []setAccessControlRecursiveFailedEntry `json:"failedEntries"`
+}
+
+type setAccessControlRecursiveFailedEntry struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// setDataLakeGen2PathAccessControlRecursive sets the ACL for a single batch of the specified Path and its children
+func setDataLakeGen2PathAccessControlRecursive(ctx context.Context, client *paths.Client, accountName, fileSystemName, path string, input setAccessControlRecursiveInput) (result setAccessControlRecursiveResult, err error) {
+	req, err := setDataLakeGen2PathAccessControlRecursivePreparer(ctx, client, accountName, fileSystemName, path, input)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", nil, "Failure preparing request")
+	}
+
+	httpResp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: httpResp}
+		return result, autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", httpResp, "Failure sending request")
+	}
+
+	if httpResp != nil && httpResp.Header != nil {
+		result.Continuation = httpResp.Header.Get("x-ms-continuation")
+	}
+
+	err = autorest.Respond(
+		httpResp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: httpResp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "datalakestore.Client", "SetAccessControlRecursive", httpResp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func setDataLakeGen2PathAccessControlRecursivePreparer(ctx context.Context, client *paths.Client, accountName, fileSystemName, path string, input setAccessControlRecursiveInput) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", fileSystemName),
+		"path":           autorest.Encode("path", path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action":    autorest.Encode("query", "setAccessControlRecursive"),
+		"mode":      autorest.Encode("query", string(input.Mode)),
+		"forceFlag": autorest.Encode("query", input.ForceFlag),
+	}
+	if input.MaxRecords > 0 {
+		queryParameters["maxRecords"] = autorest.Encode("query", input.MaxRecords)
+	}
+	if input.Continuation != nil {
+		queryParameters["continuation"] = autorest.Encode("query", *input.Continuation)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": setAccessControlRecursiveAPIVersion,
+		"x-ms-acl":     input.ACL,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.dfs.%s", accountName, client.BaseURI)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// applyDataLakeGen2PathAccessControlRecursive sets the ACL for the specified Path and all of its children, processing
+// each batch until the Continuation Token is exhausted and returning an error detailing any Paths which failed to update
+func applyDataLakeGen2PathAccessControlRecursive(ctx context.Context, client *paths.Client, accountName, fileSystemName, path string, input setAccessControlRecursiveInput) error {
+	failedEntries := make([]setAccessControlRecursiveFailedEntry, 0)
+	failureCount := 0
+
+	for {
+		result, err := setDataLakeGen2PathAccessControlRecursive(ctx, client, accountName, fileSystemName, path, input)
+		if err != nil {
+			return err
+		}
+
+		failureCount += result.FailureCount
+		failedEntries = append(failedEntries, result.FailedEntries...)
+
+		// when the operation isn't forced the API stops at the first failure, but still returns a Continuation Token
+		if result.Continuation == "" || (result.FailureCount > 0 && !input.ForceFlag) {
+			break
+		}
+		input.Continuation = &result.Continuation
+	}
+
+	if failureCount == 0 {
+		return nil
+	}
+
+	entries := make([]string, 0)
+	for _, v := range failedEntries {
+		entries = append(entries, fmt.Sprintf("%s %q: %s", v.Type, v.Name, v.ErrorMessage))
+	}
+	return fmt.Errorf("updating the ACL failed for %d Path(s):\n\n%s", failureCount, strings.Join(entries, "\n"))
+}
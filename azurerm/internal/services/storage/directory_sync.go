@@ -0,0 +1,263 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// directorySyncFile is a file within the local directory tree being synchronised
+type directorySyncFile struct {
+	// RelativePath is the path of the file relative to the source directory, using `/` as the separator
+	RelativePath string
+	FullPath     string
+	Size         int64
+
+	// ContentMD5 is the Base64 encoded MD5 of the file, matching the format returned by the Storage API
+	ContentMD5  string
+	ContentType string
+}
+
+// readDirectorySyncSource walks the directory tree at `directory`, hashing each file which is found
+func readDirectorySyncSource(directory string) (map[string]directorySyncFile, error) {
+	info, err := os.Stat(directory)
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory %q: %+v", directory, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source directory %q is not a directory", directory)
+	}
+
+	output := make(map[string]directorySyncFile)
+	err = filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return fmt.Errorf("%q is not a regular file", path)
+		}
+
+		relativePath, err := filepath.Rel(directory, path)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		hash := md5.New()
+		size, err := io.Copy(hash, file)
+		if err != nil {
+			return fmt.Errorf("hashing %q: %+v", path, err)
+		}
+
+		output[relativePath] = directorySyncFile{
+			RelativePath: relativePath,
+			FullPath:     path,
+			Size:         size,
+			ContentMD5:   base64.StdEncoding.EncodeToString(hash.Sum(nil)),
+			ContentType:  directorySyncContentType(path),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory %q: %+v", directory, err)
+	}
+
+	return output, nil
+}
+
+// directorySyncContentType determines the Content Type for a file from its extension
+func directorySyncContentType(path string) string {
+	if v := mime.TypeByExtension(filepath.Ext(path)); v != "" {
+		return v
+	}
+
+	return "application/octet-stream"
+}
+
+// directorySyncManifestHash returns a single hash representing the relative path and Content MD5 of every file,
+// allowing the contents of the local directory and the remote destination to be compared
+func directorySyncManifestHash(contentMD5s map[string]string) string {
+	paths := make([]string, 0, len(contentMD5s))
+	for path := range contentMD5s {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	hash := sha256.New()
+	for _, path := range paths {
+		hash.Write([]byte(fmt.Sprintf("%s\n%s\n", path, contentMD5s[path])))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// directorySyncCustomizeDiff hashes the local directory during the plan, so that any changes to the files within it
+// are surfaced as a single change to the `manifest_hash`, rather than a diff per file
+func directorySyncCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the source directory may be created by another resource, in which case its contents aren't known until apply
+	if !d.NewValueKnown("source_directory") {
+		if err := d.SetNewComputed("manifest_hash"); err != nil {
+			return fmt.Errorf("setting `manifest_hash` to computed: %+v", err)
+		}
+		if err := d.SetNewComputed("files"); err != nil {
+			return fmt.Errorf("setting `files` to computed: %+v", err)
+		}
+		return d.SetNewComputed("file_count")
+	}
+
+	localFiles, err := readDirectorySyncSource(d.Get("source_directory").(string))
+	if err != nil {
+		return err
+	}
+
+	contentMD5s := make(map[string]string)
+	for path, file := range localFiles {
+		contentMD5s[path] = file.ContentMD5
+	}
+
+	if manifestHash := directorySyncManifestHash(contentMD5s); manifestHash != d.Get("manifest_hash").(string) {
+		if err := d.SetNew("manifest_hash", manifestHash); err != nil {
+			return fmt.Errorf("setting `manifest_hash`: %+v", err)
+		}
+		if err := d.SetNew("file_count", len(localFiles)); err != nil {
+			return fmt.Errorf("setting `file_count`: %+v", err)
+		}
+		if err := d.SetNewComputed("files"); err != nil {
+			return fmt.Errorf("setting `files` to computed: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+// directorySyncManagedFiles returns the relative paths of the files which were synchronised by the resource (as recorded
+// in the `files` field) - only these files are ever deleted, so that anything else within the destination is left as-is
+func directorySyncManagedFiles(input []interface{}) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, v := range input {
+		output[v.(string)] = struct{}{}
+	}
+
+	return output
+}
+
+// filterDirectorySyncManagedFiles returns the Content MD5 of each of the `remoteFiles` which is managed by the resource
+func filterDirectorySyncManagedFiles(remoteFiles map[string]string, managedFiles map[string]struct{}) map[string]string {
+	output := make(map[string]string)
+	for relativePath, contentMD5 := range remoteFiles {
+		if _, ok := managedFiles[relativePath]; ok {
+			output[relativePath] = contentMD5
+		}
+	}
+
+	return output
+}
+
+// flattenDirectorySyncFiles returns the relative path of each local file, for use in the `files` field
+func flattenDirectorySyncFiles(input map[string]directorySyncFile) []interface{} {
+	output := make([]interface{}, 0, len(input))
+	for relativePath := range input {
+		output = append(output, relativePath)
+	}
+
+	return output
+}
+
+// directorySyncResourceID returns the ID of a Directory Sync, which is the URL of the `path` within the Container or
+// File Share with a trailing `/`, so that it's distinct from the ID of the Container or File Share itself
+func directorySyncResourceID(parentId, path string) string {
+	if path == "" {
+		return fmt.Sprintf("%s/", parentId)
+	}
+
+	return fmt.Sprintf("%s/%s/", parentId, path)
+}
+
+// directorySyncRelativePath returns the path of the remote file `name` relative to the destination `path`
+func directorySyncRelativePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return strings.TrimPrefix(name, fmt.Sprintf("%s/", path))
+}
+
+// directorySyncRemotePath returns the full remote path of the file at `relativePath` within the destination `path`
+func directorySyncRemotePath(path, relativePath string) string {
+	if path == "" {
+		return relativePath
+	}
+
+	return fmt.Sprintf("%s/%s", path, relativePath)
+}
+
+// runDirectorySyncOperations runs each of the operations using `parallelism` workers, returning the first error
+func runDirectorySyncOperations(ctx context.Context, parallelism int, operations []func(ctx context.Context) error) error {
+	if len(operations) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	workerCount := parallelism
+	if workerCount > len(operations) {
+		workerCount = len(operations)
+	}
+
+	queue := make(chan func(ctx context.Context) error, len(operations))
+	for _, operation := range operations {
+		queue <- operation
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	errors := make(chan error, len(operations))
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for operation := range queue {
+				if ctx.Err() != nil {
+					return
+				}
+
+				if err := operation(ctx); err != nil {
+					errors <- err
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errors)
+
+	if err, ok := <-errors; ok {
+		return err
+	}
+
+	return ctx.Err()
+}
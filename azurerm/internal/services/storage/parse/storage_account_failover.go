@@ -0,0 +1,57 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This is manual since the ID of a Storage Account Failover is the ID of the Storage Account with a `/failover` suffix,
+// which isn't supported in auto-generation
+
+const storageAccountFailoverSuffix = "/failover"
+
+type StorageAccountFailoverId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewStorageAccountFailoverID(subscriptionId, resourceGroup, name string) StorageAccountFailoverId {
+	return StorageAccountFailoverId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id StorageAccountFailoverId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Failover", segmentsStr)
+}
+
+func (id StorageAccountFailoverId) ID() string {
+	return id.StorageAccountID().ID() + storageAccountFailoverSuffix
+}
+
+func (id StorageAccountFailoverId) StorageAccountID() StorageAccountId {
+	return NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// StorageAccountFailoverID parses a StorageAccountFailover ID into an StorageAccountFailoverId struct
+func StorageAccountFailoverID(input string) (*StorageAccountFailoverId, error) {
+	if !strings.HasSuffix(input, storageAccountFailoverSuffix) {
+		return nil, fmt.Errorf("ID was missing the %q suffix", storageAccountFailoverSuffix)
+	}
+
+	accountId, err := StorageAccountID(strings.TrimSuffix(input, storageAccountFailoverSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NewStorageAccountFailoverID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name)
+	return &resourceId, nil
+}
@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = StorageShareSnapshotDataPlaneId{}
+
+type StorageShareSnapshotDataPlaneId struct {
+	AccountName  string
+	DomainSuffix string
+	ShareName    string
+	Snapshot     string
+}
+
+func (id StorageShareSnapshotDataPlaneId) ID() string {
+	return fmt.Sprintf("https://%s.file.%s/%s?sharesnapshot=%s", id.AccountName, id.DomainSuffix, id.ShareName, id.Snapshot)
+}
+
+func (id StorageShareSnapshotDataPlaneId) ShareId() StorageShareDataPlaneId {
+	return NewStorageShareDataPlaneId(id.AccountName, id.DomainSuffix, id.ShareName)
+}
+
+func NewStorageShareSnapshotDataPlaneId(accountName, domainSuffix, shareName, snapshot string) StorageShareSnapshotDataPlaneId {
+	return StorageShareSnapshotDataPlaneId{
+		AccountName:  accountName,
+		DomainSuffix: domainSuffix,
+		ShareName:    shareName,
+		Snapshot:     snapshot,
+	}
+}
+
+func StorageShareSnapshotDataPlaneID(input string) (*StorageShareSnapshotDataPlaneId, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URL: %+v", input, err)
+	}
+
+	snapshot := uri.Query().Get("sharesnapshot")
+	if snapshot == "" {
+		return nil, fmt.Errorf("expected the query string of %q to contain a `sharesnapshot`", input)
+	}
+
+	uri.RawQuery = ""
+	shareId, err := StorageShareDataPlaneID(uri.String())
+	if err != nil {
+		return nil, err
+	}
+	if shareId.Name == "" || strings.Contains(shareId.Name, "/") {
+		return nil, fmt.Errorf("expected %q to contain a single Share Name but got %q", input, shareId.Name)
+	}
+
+	return &StorageShareSnapshotDataPlaneId{
+		AccountName:  shareId.AccountName,
+		DomainSuffix: shareId.DomainSuffix,
+		ShareName:    shareId.Name,
+		Snapshot:     snapshot,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageShareSnapshotDataPlaneIDFormatter(t *testing.T) {
+	actual := NewStorageShareSnapshotDataPlaneId("account1", "core.windows.net", "share1", "2021-09-01T10:19:25.0000000Z").ID()
+	expected := "https://account1.file.core.windows.net/share1?sharesnapshot=2021-09-01T10:19:25.0000000Z"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageShareSnapshotDataPlaneID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageShareSnapshotDataPlaneId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// share without a snapshot
+			Input: "https://account1.file.core.windows.net/share1",
+			Error: true,
+		},
+
+		{
+			// empty snapshot
+			Input: "https://account1.file.core.windows.net/share1?sharesnapshot=",
+			Error: true,
+		},
+
+		{
+			// missing share
+			Input: "https://account1.file.core.windows.net/?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Error: true,
+		},
+
+		{
+			// file within a snapshot
+			Input: "https://account1.file.core.windows.net/share1/file1.txt?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "https://account1.file.core.windows.net/share1?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Expected: &StorageShareSnapshotDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "core.windows.net",
+				ShareName:    "share1",
+				Snapshot:     "2021-09-01T10:19:25.0000000Z",
+			},
+		},
+
+		{
+			// valid - sovereign cloud
+			Input: "https://account1.file.core.chinacloudapi.cn/share1?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Expected: &StorageShareSnapshotDataPlaneId{
+				AccountName:  "account1",
+				DomainSuffix: "core.chinacloudapi.cn",
+				ShareName:    "share1",
+				Snapshot:     "2021-09-01T10:19:25.0000000Z",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageShareSnapshotDataPlaneID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.AccountName != v.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for AccountName", v.Expected.AccountName, actual.AccountName)
+		}
+		if actual.DomainSuffix != v.Expected.DomainSuffix {
+			t.Fatalf("Expected %q but got %q for DomainSuffix", v.Expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.ShareName != v.Expected.ShareName {
+			t.Fatalf("Expected %q but got %q for ShareName", v.Expected.ShareName, actual.ShareName)
+		}
+		if actual.Snapshot != v.Expected.Snapshot {
+			t.Fatalf("Expected %q but got %q for Snapshot", v.Expected.Snapshot, actual.Snapshot)
+		}
+	}
+}
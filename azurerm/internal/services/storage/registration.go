@@ -25,9 +25,11 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_account_sas":                dataSourceStorageAccountSharedAccessSignature(),
 		"azurerm_storage_account":                    dataSourceStorageAccount(),
 		"azurerm_storage_blob":                       dataSourceStorageBlob(),
+		"azurerm_storage_blobs":                      dataSourceStorageBlobs(),
 		"azurerm_storage_container":                  dataSourceStorageContainer(),
 		"azurerm_storage_encryption_scope":           dataSourceStorageEncryptionScope(),
 		"azurerm_storage_management_policy":          dataSourceStorageManagementPolicy(),
+		"azurerm_storage_share_snapshots":            dataSourceStorageShareSnapshots(),
 		"azurerm_storage_sync":                       dataSourceStorageSync(),
 		"azurerm_storage_sync_group":                 dataSourceStorageSyncGroup(),
 		"azurerm_storage_table_entity":               dataSourceStorageTableEntity(),
@@ -37,25 +39,32 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_storage_account":                      resourceStorageAccount(),
-		"azurerm_storage_account_customer_managed_key": resourceStorageAccountCustomerManagedKey(),
-		"azurerm_storage_account_network_rules":        resourceStorageAccountNetworkRules(),
-		"azurerm_storage_blob":                         resourceStorageBlob(),
-		"azurerm_storage_blob_inventory_policy":        resourceStorageBlobInventoryPolicy(),
-		"azurerm_storage_container":                    resourceStorageContainer(),
-		"azurerm_storage_encryption_scope":             resourceStorageEncryptionScope(),
-		"azurerm_storage_data_lake_gen2_filesystem":    resourceStorageDataLakeGen2FileSystem(),
-		"azurerm_storage_data_lake_gen2_path":          resourceStorageDataLakeGen2Path(),
-		"azurerm_storage_management_policy":            resourceStorageManagementPolicy(),
-		"azurerm_storage_object_replication":           resourceStorageObjectReplication(),
-		"azurerm_storage_queue":                        resourceStorageQueue(),
-		"azurerm_storage_share":                        resourceStorageShare(),
-		"azurerm_storage_share_file":                   resourceStorageShareFile(),
-		"azurerm_storage_share_directory":              resourceStorageShareDirectory(),
-		"azurerm_storage_table":                        resourceStorageTable(),
-		"azurerm_storage_table_entity":                 resourceStorageTableEntity(),
-		"azurerm_storage_sync":                         resourceStorageSync(),
-		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
-		"azurerm_storage_sync_group":                   resourceStorageSyncGroup(),
+		"azurerm_storage_account":                       resourceStorageAccount(),
+		"azurerm_storage_account_customer_managed_key":  resourceStorageAccountCustomerManagedKey(),
+		"azurerm_storage_account_failover":              resourceStorageAccountFailover(),
+		"azurerm_storage_account_local_user":            resourceStorageAccountLocalUser(),
+		"azurerm_storage_account_network_rules":         resourceStorageAccountNetworkRules(),
+		"azurerm_storage_blob":                          resourceStorageBlob(),
+		"azurerm_storage_blob_directory_sync":           resourceStorageBlobDirectorySync(),
+		"azurerm_storage_blob_inventory_policy":         resourceStorageBlobInventoryPolicy(),
+		"azurerm_storage_container":                     resourceStorageContainer(),
+		"azurerm_storage_container_immutability_policy": resourceStorageContainerImmutabilityPolicy(),
+		"azurerm_storage_container_legal_hold":          resourceStorageContainerLegalHold(),
+		"azurerm_storage_encryption_scope":              resourceStorageEncryptionScope(),
+		"azurerm_storage_data_lake_gen2_filesystem":     resourceStorageDataLakeGen2FileSystem(),
+		"azurerm_storage_data_lake_gen2_path":           resourceStorageDataLakeGen2Path(),
+		"azurerm_storage_management_policy":             resourceStorageManagementPolicy(),
+		"azurerm_storage_object_replication":            resourceStorageObjectReplication(),
+		"azurerm_storage_queue":                         resourceStorageQueue(),
+		"azurerm_storage_share":                         resourceStorageShare(),
+		"azurerm_storage_share_file":                    resourceStorageShareFile(),
+		"azurerm_storage_share_directory":               resourceStorageShareDirectory(),
+		"azurerm_storage_share_directory_sync":          resourceStorageShareDirectorySync(),
+		"azurerm_storage_share_snapshot":                resourceStorageShareSnapshot(),
+		"azurerm_storage_table":                         resourceStorageTable(),
+		"azurerm_storage_table_entity":                  resourceStorageTableEntity(),
+		"azurerm_storage_sync":                          resourceStorageSync(),
+		"azurerm_storage_sync_cloud_endpoint":           resourceStorageSyncCloudEndpoint(),
+		"azurerm_storage_sync_group":                    resourceStorageSyncGroup(),
 	}
 }
@@ -0,0 +1,126 @@
+package localusers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type LocalUserId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	Name               string
+}
+
+func NewLocalUserID(subscriptionId, resourceGroup, storageAccountName, name string) LocalUserId {
+	return LocalUserId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		Name:               name,
+	}
+}
+
+func (id LocalUserId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "LocalUser", segmentsStr)
+}
+
+func (id LocalUserId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/localUsers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.Name)
+}
+
+// LocalUserID parses a LocalUser ID into an LocalUserId struct
+func LocalUserID(input string) (*LocalUserId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LocalUserId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("localUsers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// LocalUserIDInsensitively parses an LocalUser ID into an LocalUserId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the LocalUserID method should be used instead for validation etc.
+func LocalUserIDInsensitively(input string) (*LocalUserId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LocalUserId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'storageAccounts' segment
+	storageAccountsKey := "storageAccounts"
+	for key := range id.Path {
+		if strings.EqualFold(key, storageAccountsKey) {
+			storageAccountsKey = key
+			break
+		}
+	}
+	if resourceId.StorageAccountName, err = id.PopSegment(storageAccountsKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'localUsers' segment
+	localUsersKey := "localUsers"
+	for key := range id.Path {
+		if strings.EqualFold(key, localUsersKey) {
+			localUsersKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(localUsersKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package localusers
+
+type LocalUserKeys struct {
+	SharedKey         *string         `json:"sharedKey,omitempty"`
+	SshAuthorizedKeys *[]SshPublicKey `json:"sshAuthorizedKeys,omitempty"`
+}
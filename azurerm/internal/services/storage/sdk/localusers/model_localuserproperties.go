@@ -0,0 +1,11 @@
+package localusers
+
+type LocalUserProperties struct {
+	HasSharedKey      *bool              `json:"hasSharedKey,omitempty"`
+	HasSshKey         *bool              `json:"hasSshKey,omitempty"`
+	HasSshPassword    *bool              `json:"hasSshPassword,omitempty"`
+	HomeDirectory     *string            `json:"homeDirectory,omitempty"`
+	PermissionScopes  *[]PermissionScope `json:"permissionScopes,omitempty"`
+	Sid               *string            `json:"sid,omitempty"`
+	SshAuthorizedKeys *[]SshPublicKey    `json:"sshAuthorizedKeys,omitempty"`
+}
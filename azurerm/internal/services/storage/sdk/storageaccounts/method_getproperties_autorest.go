@@ -0,0 +1,64 @@
+package storageaccounts
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetPropertiesResponse struct {
+	HttpResponse *http.Response
+	Model        *StorageAccount
+}
+
+// GetProperties ...
+func (c StorageAccountsClient) GetProperties(ctx context.Context, id StorageAccountId) (result GetPropertiesResponse, err error) {
+	req, err := c.preparerForGetProperties(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storageaccounts.StorageAccountsClient", "GetProperties", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storageaccounts.StorageAccountsClient", "GetProperties", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetProperties(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "storageaccounts.StorageAccountsClient", "GetProperties", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetProperties prepares the GetProperties request.
+func (c StorageAccountsClient) preparerForGetProperties(ctx context.Context, id StorageAccountId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetProperties handles the response to the GetProperties request. The method always
+// closes the http.Response Body.
+func (c StorageAccountsClient) responderForGetProperties(resp *http.Response) (result GetPropertiesResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
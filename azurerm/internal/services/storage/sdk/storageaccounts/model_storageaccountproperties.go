@@ -0,0 +1,7 @@
+package storageaccounts
+
+type StorageAccountProperties struct {
+	IsHnsEnabled       *bool `json:"isHnsEnabled,omitempty"`
+	IsLocalUserEnabled *bool `json:"isLocalUserEnabled,omitempty"`
+	IsSftpEnabled      *bool `json:"isSftpEnabled,omitempty"`
+}
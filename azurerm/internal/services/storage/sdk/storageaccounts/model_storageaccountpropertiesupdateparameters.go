@@ -0,0 +1,6 @@
+package storageaccounts
+
+type StorageAccountPropertiesUpdateParameters struct {
+	IsLocalUserEnabled *bool `json:"isLocalUserEnabled,omitempty"`
+	IsSftpEnabled      *bool `json:"isSftpEnabled,omitempty"`
+}
@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/file/directories"
+)
+
+// NOTE: the version of the Storage SDK in use doesn't expose the List Directories and Files API, as such
+// this is a minimal implementation using the Directories Client, which can be removed once the SDK supports it
+
+type shareDirectoryListResult struct {
+	autorest.Response
+
+	Entries    shareDirectoryListEntries `xml:"Entries"`
+	NextMarker *string                   `xml:"NextMarker"`
+}
+
+type shareDirectoryListEntries struct {
+	Directories []shareDirectoryListEntry `xml:"Directory"`
+	Files       []shareDirectoryListEntry `xml:"File"`
+}
+
+type shareDirectoryListEntry struct {
+	Name string `xml:"Name"`
+}
+
+// listShareDirectoryContents returns the names of the Files and Directories directly within the Directory at `path`,
+// where an empty `path` is the root of the Share
+func listShareDirectoryContents(ctx context.Context, client *directories.Client, accountName, shareName, path string) (files []string, dirs []string, resp autorest.Response, err error) {
+	var marker *string
+	for {
+		req, err := listShareDirectoryContentsPreparer(ctx, client, accountName, shareName, path, marker)
+		if err != nil {
+			return nil, nil, resp, autorest.NewErrorWithError(err, "directories.Client", "List", nil, "Failure preparing request")
+		}
+
+		httpResp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+		if err != nil {
+			resp = autorest.Response{Response: httpResp}
+			return nil, nil, resp, autorest.NewErrorWithError(err, "directories.Client", "List", httpResp, "Failure sending request")
+		}
+
+		var result shareDirectoryListResult
+		err = autorest.Respond(
+			httpResp,
+			client.ByInspecting(),
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingXML(&result),
+			autorest.ByClosing())
+		resp = autorest.Response{Response: httpResp}
+		if err != nil {
+			return nil, nil, resp, autorest.NewErrorWithError(err, "directories.Client", "List", httpResp, "Failure responding to request")
+		}
+
+		for _, v := range result.Entries.Files {
+			files = append(files, v.Name)
+		}
+		for _, v := range result.Entries.Directories {
+			dirs = append(dirs, v.Name)
+		}
+
+		if result.NextMarker == nil || *result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+
+	return files, dirs, resp, nil
+}
+
+func listShareDirectoryContentsPreparer(ctx context.Context, client *directories.Client, accountName, shareName, path string, marker *string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"shareName": autorest.Encode("path", shareName),
+		"directory": autorest.Encode("path", path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"restype": autorest.Encode("query", "directory"),
+		"comp":    autorest.Encode("query", "list"),
+	}
+	if marker != nil {
+		queryParameters["marker"] = autorest.Encode("query", *marker)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": directories.APIVersion,
+	}
+
+	// the root of the Share is listed using the Share's path
+	pathFormat := "/{shareName}/{directory}"
+	if path == "" {
+		pathFormat = "/{shareName}"
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.file.%s", accountName, client.BaseURI)),
+		autorest.WithPathParameters(pathFormat, pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
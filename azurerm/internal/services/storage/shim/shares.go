@@ -8,9 +8,12 @@ import (
 
 type StorageShareWrapper interface {
 	Create(ctx context.Context, resourceGroup, accountName, shareName string, input shares.CreateInput) error
+	CreateSnapshot(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) (*string, error)
 	Delete(ctx context.Context, resourceGroup, accountName, shareName string) error
+	DeleteSnapshot(ctx context.Context, resourceGroup, accountName, shareName, snapshot string) error
 	Exists(ctx context.Context, resourceGroup, accountName, shareName string) (*bool, error)
 	Get(ctx context.Context, resourceGroup, accountName, shareName string) (*StorageShareProperties, error)
+	GetSnapshot(ctx context.Context, resourceGroup, accountName, shareName, snapshot string) (*StorageShareSnapshotProperties, error)
 	UpdateACLs(ctx context.Context, resourceGroup, accountName, shareName string, acls []shares.SignedIdentifier) error
 	UpdateMetaData(ctx context.Context, resourceGroup, accountName, shareName string, metaData map[string]string) error
 	UpdateQuota(ctx context.Context, resourceGroup, accountName, shareName string, quotaGB int) error
@@ -21,3 +24,7 @@ type StorageShareProperties struct {
 	MetaData map[string]string
 	QuotaGB  int
 }
+
+type StorageShareSnapshotProperties struct {
+	MetaData map[string]string
+}
@@ -52,12 +52,29 @@ func (w DataPlaneStorageShareWrapper) Create(ctx context.Context, _, accountName
 	return err
 }
 
+func (w DataPlaneStorageShareWrapper) CreateSnapshot(ctx context.Context, _, accountName, shareName string, metaData map[string]string) (*string, error) {
+	input := shares.CreateSnapshotInput{
+		MetaData: metaData,
+	}
+	resp, err := w.client.CreateSnapshot(ctx, accountName, shareName, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.String(resp.SnapshotDateTime), nil
+}
+
 func (w DataPlaneStorageShareWrapper) Delete(ctx context.Context, _, accountName, shareName string) error {
 	deleteSnapshots := true
 	_, err := w.client.Delete(ctx, accountName, shareName, deleteSnapshots)
 	return err
 }
 
+func (w DataPlaneStorageShareWrapper) DeleteSnapshot(ctx context.Context, _, accountName, shareName, snapshot string) error {
+	_, err := w.client.DeleteSnapshot(ctx, accountName, shareName, snapshot)
+	return err
+}
+
 func (w DataPlaneStorageShareWrapper) Exists(ctx context.Context, _, accountName, shareName string) (*bool, error) {
 	existing, err := w.client.GetProperties(ctx, accountName, shareName)
 	if err != nil {
@@ -93,6 +110,21 @@ func (w DataPlaneStorageShareWrapper) Get(ctx context.Context, _, accountName, s
 	}, nil
 }
 
+func (w DataPlaneStorageShareWrapper) GetSnapshot(ctx context.Context, _, accountName, shareName, snapshot string) (*StorageShareSnapshotProperties, error) {
+	props, err := w.client.GetSnapshot(ctx, accountName, shareName, snapshot)
+	if err != nil {
+		if utils.ResponseWasNotFound(props.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &StorageShareSnapshotProperties{
+		MetaData: props.MetaData,
+	}, nil
+}
+
 func (w DataPlaneStorageShareWrapper) UpdateACLs(ctx context.Context, _, accountName, shareName string, acls []shares.SignedIdentifier) error {
 	_, err := w.client.SetACL(ctx, accountName, shareName, acls)
 	return err
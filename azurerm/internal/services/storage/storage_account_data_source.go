@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	azautorest "github.com/Azure/go-autorest/autorest"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/storageaccounts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -84,11 +86,26 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"shared_access_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"is_hns_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"local_user_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"primary_location": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -99,6 +116,16 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"geo_replication_last_sync_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"geo_replication_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_blob_endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -322,6 +349,12 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("allow_blob_public_access", props.AllowBlobPublicAccess)
 
+		sharedKeyAccessEnabled := true
+		if props.AllowSharedKeyAccess != nil {
+			sharedKeyAccessEnabled = *props.AllowSharedKeyAccess
+		}
+		d.Set("shared_access_key_enabled", sharedKeyAccessEnabled)
+
 		if customDomain := props.CustomDomain; customDomain != nil {
 			if err := d.Set("custom_domain", flattenStorageAccountCustomDomain(customDomain)); err != nil {
 				return fmt.Errorf("Error setting `custom_domain`: %+v", err)
@@ -332,6 +365,25 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("primary_location", props.PrimaryLocation)
 		d.Set("secondary_location", props.SecondaryLocation)
 
+		// the Geo Replication Stats are frequently unavailable (e.g. whilst the secondary location is being bootstrapped
+		// or during a failover), as such a failure to retrieve them is logged rather than failing the refresh
+		geoReplicationStats, err := retrieveStorageAccountGeoReplicationStats(ctx, client, resourceGroup, name, resp.Sku)
+		if err != nil {
+			log.Printf("[WARN] %+v - `geo_replication_last_sync_time` and `geo_replication_status` will be empty", err)
+		}
+		lastSyncTime, geoReplicationStatus := flattenStorageAccountGeoReplicationStats(geoReplicationStats)
+		d.Set("geo_replication_last_sync_time", lastSyncTime)
+		d.Set("geo_replication_status", geoReplicationStatus)
+
+		storageAccountsClient := meta.(*clients.Client).Storage.StorageAccountsClient
+		subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+		sftpEnabled, localUserEnabled, err := retrieveStorageAccountSftpProperties(ctx, storageAccountsClient, storageaccounts.NewStorageAccountID(subscriptionId, resourceGroup, name))
+		if err != nil {
+			return err
+		}
+		d.Set("sftp_enabled", sftpEnabled)
+		d.Set("local_user_enabled", localUserEnabled)
+
 		if accessKeys := accountKeys; accessKeys != nil {
 			storageAccessKeys := *accessKeys
 			if len(storageAccessKeys) > 0 {
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountFailover() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountFailoverCreate,
+		Read:   resourceStorageAccountFailoverRead,
+		Delete: resourceStorageAccountFailoverDelete,
+
+		// a failover is an action performed against the Storage Account, so there's nothing which can be imported
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(120 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"primary_location": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_sync_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountFailoverCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, storageAccountResourceName)
+	defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+	existing, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if !storageAccountIsGeoReplicated(existing.Sku) {
+		return fmt.Errorf("failing over %s: the Storage Account must be replicated to a secondary location (`GRS`, `RAGRS`, `GZRS` or `RAGZRS`)", *id)
+	}
+
+	geoReplicationStats, err := retrieveStorageAccountGeoReplicationStats(ctx, client, id.ResourceGroup, id.Name, existing.Sku)
+	if err != nil {
+		return err
+	}
+	if geoReplicationStats == nil || geoReplicationStats.CanFailover == nil || !*geoReplicationStats.CanFailover {
+		return fmt.Errorf("failing over %s: failover isn't currently supported for this Storage Account", *id)
+	}
+
+	// writes made after the Last Sync Time may be lost by the failover, so this is recorded for reference
+	lastSyncTime, _ := flattenStorageAccountGeoReplicationStats(geoReplicationStats)
+
+	log.Printf("[INFO] Failing over %s to the secondary location..", *id)
+	future, err := client.Failover(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("failing over %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for failover of %s: %+v", *id, err)
+	}
+
+	resp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	primaryLocation := ""
+	if props := resp.AccountProperties; props != nil && props.PrimaryLocation != nil {
+		primaryLocation = *props.PrimaryLocation
+	}
+
+	d.SetId(parse.NewStorageAccountFailoverID(id.SubscriptionId, id.ResourceGroup, id.Name).ID())
+	d.Set("primary_location", primaryLocation)
+	d.Set("last_sync_time", lastSyncTime)
+
+	return resourceStorageAccountFailoverRead(d, meta)
+}
+
+func resourceStorageAccountFailoverRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountFailoverID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountId := id.StorageAccountID()
+
+	resp, err := client.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing the Failover from state", accountId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	// the `primary_location` and `last_sync_time` record the result of the failover, rather than the current state
+	d.Set("storage_account_id", accountId.ID())
+
+	return nil
+}
+
+func resourceStorageAccountFailoverDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	id, err := parse.StorageAccountFailoverID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountId := id.StorageAccountID()
+
+	// a failover can't be undone, the Storage Account has to be reconfigured for geo-replication (and failed over again)
+	log.Printf("[DEBUG] Failovers can't be reverted - removing the Failover of %s from state", accountId)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type StorageAccountFailoverResource struct{}
+
+func TestAccStorageAccountFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_failover", "test")
+	r := StorageAccountFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the Storage Account is read prior to the failover, so this is the original secondary location
+				check.That(data.ResourceName).Key("primary_location").MatchesOtherKey(
+					check.That("azurerm_storage_account.test").Key("secondary_location"),
+				),
+				check.That(data.ResourceName).Key("last_sync_time").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccStorageAccountFailover_locallyRedundant(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_failover", "test")
+	r := StorageAccountFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.locallyRedundant(data),
+			ExpectError: regexp.MustCompile("the Storage Account must be replicated to a secondary location"),
+		},
+	})
+}
+
+func (r StorageAccountFailoverResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountFailoverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	accountId := id.StorageAccountID()
+
+	resp, err := client.Storage.AccountsClient.GetProperties(ctx, accountId.ResourceGroup, accountId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	// once failed over the account is no longer replicated to a secondary location
+	return utils.Bool(resp.Sku != nil && resp.Sku.Name == "Standard_LRS"), nil
+}
+
+func (r StorageAccountFailoverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "RAGRS"
+
+  lifecycle {
+    ignore_changes = [account_replication_type]
+  }
+}
+
+resource "azurerm_storage_account_failover" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountFailoverResource) locallyRedundant(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_account_failover" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
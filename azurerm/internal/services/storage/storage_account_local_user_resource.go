@@ -0,0 +1,479 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/localusers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := localusers.LocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// a new password is generated when password authentication is enabled
+			if d.HasChange("ssh_password_enabled") {
+				return d.SetNewComputed("password")
+			}
+
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountLocalUserName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+
+						// the name of the Storage Container or Storage Share
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := localusers.NewLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	input, err := expandStorageAccountLocalUser(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, *input); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// the password is generated by the service, rather than being specified
+	if d.Get("ssh_password_enabled").(bool) {
+		password, err := regenerateStorageAccountLocalUserPassword(ctx, client, id)
+		if err != nil {
+			return err
+		}
+		d.Set("password", password)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input, err := expandStorageAccountLocalUser(d)
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, *input); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	if d.HasChange("ssh_password_enabled") {
+		password := ""
+		if d.Get("ssh_password_enabled").(bool) {
+			password, err = regenerateStorageAccountLocalUserPassword(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+		}
+		d.Set("password", password)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the SSH Authorized Keys aren't returned when retrieving the Local User, only when listing its keys
+	keys, err := client.ListKeys(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("listing keys for %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+		d.Set("home_directory", props.HomeDirectory)
+		d.Set("ssh_key_enabled", props.HasSshKey != nil && *props.HasSshKey)
+		d.Set("ssh_password_enabled", props.HasSshPassword != nil && *props.HasSshPassword)
+		d.Set("sid", props.Sid)
+
+		if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+			return fmt.Errorf("setting `permission_scope`: %+v", err)
+		}
+	}
+
+	var sshAuthorizedKeys *[]localusers.SshPublicKey
+	if model := keys.Model; model != nil {
+		sshAuthorizedKeys = model.SshAuthorizedKeys
+	}
+	if err := d.Set("ssh_authorized_key", flattenStorageAccountLocalUserSshAuthorizedKeys(sshAuthorizedKeys)); err != nil {
+		return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func regenerateStorageAccountLocalUserPassword(ctx context.Context, client *localusers.LocalUsersClient, id localusers.LocalUserId) (string, error) {
+	resp, err := client.RegeneratePassword(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("generating the SSH Password for %s: %+v", id, err)
+	}
+
+	if resp.Model == nil || resp.Model.SshPassword == nil {
+		return "", fmt.Errorf("generating the SSH Password for %s: `sshPassword` was nil", id)
+	}
+
+	return *resp.Model.SshPassword, nil
+}
+
+func expandStorageAccountLocalUser(d *pluginsdk.ResourceData) (*localusers.LocalUser, error) {
+	sshKeyEnabled := d.Get("ssh_key_enabled").(bool)
+	sshAuthorizedKeys := expandStorageAccountLocalUserSshAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{}))
+	if !sshKeyEnabled && len(sshAuthorizedKeys) > 0 {
+		return nil, fmt.Errorf("`ssh_authorized_key` can only be specified when `ssh_key_enabled` is `true`")
+	}
+
+	props := localusers.LocalUserProperties{
+		HasSshKey:         utils.Bool(sshKeyEnabled),
+		HasSshPassword:    utils.Bool(d.Get("ssh_password_enabled").(bool)),
+		PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+		SshAuthorizedKeys: &sshAuthorizedKeys,
+	}
+
+	if v := d.Get("home_directory").(string); v != "" {
+		props.HomeDirectory = utils.String(v)
+	}
+
+	return &localusers.LocalUser{
+		Properties: &props,
+	}, nil
+}
+
+func expandStorageAccountLocalUserSshAuthorizedKeys(input []interface{}) []localusers.SshPublicKey {
+	output := make([]localusers.SshPublicKey, 0)
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		key := localusers.SshPublicKey{
+			Key: utils.String(v["key"].(string)),
+		}
+		if description := v["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+
+		output = append(output, key)
+	}
+
+	return output
+}
+
+func flattenStorageAccountLocalUserSshAuthorizedKeys(input *[]localusers.SshPublicKey) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		key := ""
+		if v.Key != nil {
+			key = *v.Key
+		}
+
+		description := ""
+		if v.Description != nil {
+			description = *v.Description
+		}
+
+		output = append(output, map[string]interface{}{
+			"key":         key,
+			"description": description,
+		})
+	}
+
+	return output
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]localusers.PermissionScope {
+	output := make([]localusers.PermissionScope, 0)
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+
+		output = append(output, localusers.PermissionScope{
+			Service:      v["service"].(string),
+			ResourceName: v["resource_name"].(string),
+			Permissions:  expandStorageAccountLocalUserPermissions(v["permissions"].([]interface{})),
+		})
+	}
+
+	return &output
+}
+
+// expandStorageAccountLocalUserPermissions returns the permissions in the form `rwdlc`
+func expandStorageAccountLocalUserPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+	v := input[0].(map[string]interface{})
+
+	permissions := ""
+	if v["read"].(bool) {
+		permissions += "r"
+	}
+	if v["write"].(bool) {
+		permissions += "w"
+	}
+	if v["delete"].(bool) {
+		permissions += "d"
+	}
+	if v["list"].(bool) {
+		permissions += "l"
+	}
+	if v["create"].(bool) {
+		permissions += "c"
+	}
+
+	return permissions
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]localusers.PermissionScope) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, map[string]interface{}{
+			"service":       v.Service,
+			"resource_name": v.ResourceName,
+			"permissions": []interface{}{
+				map[string]interface{}{
+					"read":   strings.Contains(v.Permissions, "r"),
+					"write":  strings.Contains(v.Permissions, "w"),
+					"delete": strings.Contains(v.Permissions, "d"),
+					"list":   strings.Contains(v.Permissions, "l"),
+					"create": strings.Contains(v.Permissions, "c"),
+				},
+			},
+		})
+	}
+
+	return output
+}
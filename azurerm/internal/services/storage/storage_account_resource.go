@@ -21,6 +21,7 @@ import (
 	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/migration"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/storageaccounts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -234,12 +235,30 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"local_user_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"allow_blob_public_access": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 
+			"shared_access_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"network_rules": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -674,6 +693,16 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"geo_replication_last_sync_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"geo_replication_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"primary_blob_endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -858,6 +887,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}
 			}
 
+			if d.Get("sftp_enabled").(bool) && !d.Get("is_hns_enabled").(bool) {
+				return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+			}
+
 			return nil
 		}),
 	}
@@ -903,7 +936,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	// we are making this change in Terraform https://github.com/terraform-providers/terraform-provider-azurerm/issues/11689
 	// because the portal UI team has a bug in their code ignoring the ARM API documention which state that nil is true
 	// TODO: Remove code when Portal UI team fixes their code
-	allowSharedKeyAccess := true
+	allowSharedKeyAccess := d.Get("shared_access_key_enabled").(bool)
 
 	parameters := storage.AccountCreateParameters{
 		Location: &location,
@@ -1029,6 +1062,19 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	// SFTP and Local Users aren't supported by the version of the Storage API used to create the Storage Account
+	// so these are configured once it exists, when they differ from the defaults
+	sftpEnabled := d.Get("sftp_enabled").(bool)
+	localUserEnabled := d.Get("local_user_enabled").(bool)
+	if sftpEnabled || !localUserEnabled {
+		storageAccountsClient := meta.(*clients.Client).Storage.StorageAccountsClient
+		subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+		storageAccountId := storageaccounts.NewStorageAccountID(subscriptionId, resourceGroupName, storageAccountName)
+		if err := updateStorageAccountSftpProperties(ctx, storageAccountsClient, storageAccountId, sftpEnabled, localUserEnabled); err != nil {
+			return err
+		}
+	}
+
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.FileStorage) {
@@ -1162,8 +1208,22 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	// account already exists. since I have also switched up the default behavor for net new storage accounts to always set this value as true, this issue
 	// should automatically correct itself over time with these changes.
 	// TODO: Remove code when Portal UI team fixes their code
-	existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
-	if err == nil {
+	if d.HasChange("shared_access_key_enabled") {
+		allowSharedKeyAccess := d.Get("shared_access_key_enabled").(bool)
+
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				AllowSharedKeyAccess: &allowSharedKeyAccess,
+			},
+		}
+
+		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
+			return fmt.Errorf("updating Azure Storage Account `shared_access_key_enabled` %q: %+v", storageAccountName, err)
+		}
+
+		// the cached Account Details determine how the Data Plane API's are authenticated against, so need to be refreshed
+		meta.(*clients.Client).Storage.RemoveAccountFromCache(storageAccountName)
+	} else if existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, ""); err == nil {
 		if sharedKeyAccess := existing.AccountProperties.AllowSharedKeyAccess; sharedKeyAccess == nil {
 			allowSharedKeyAccess := true
 
@@ -1490,6 +1550,14 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChanges("sftp_enabled", "local_user_enabled") {
+		storageAccountsClient := meta.(*clients.Client).Storage.StorageAccountsClient
+		storageAccountId := storageaccounts.NewStorageAccountID(id.SubscriptionID, resourceGroupName, storageAccountName)
+		if err := updateStorageAccountSftpProperties(ctx, storageAccountsClient, storageAccountId, d.Get("sftp_enabled").(bool), d.Get("local_user_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -1564,6 +1632,14 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
 		d.Set("allow_blob_public_access", props.AllowBlobPublicAccess)
+
+		// the API defaults to allowing Shared Key Access when this isn't set
+		sharedKeyAccessEnabled := true
+		if props.AllowSharedKeyAccess != nil {
+			sharedKeyAccessEnabled = *props.AllowSharedKeyAccess
+		}
+		d.Set("shared_access_key_enabled", sharedKeyAccessEnabled)
+
 		// For all Clouds except Public, China, and USGovernmentCloud, "min_tls_version" is not returned from Azure so always persist the default values for "min_tls_version".
 		// https://github.com/terraform-providers/terraform-provider-azurerm/issues/7812
 		// https://github.com/terraform-providers/terraform-provider-azurerm/issues/8083
@@ -1591,6 +1667,24 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("primary_location", props.PrimaryLocation)
 		d.Set("secondary_location", props.SecondaryLocation)
 
+		// the Geo Replication Stats are frequently unavailable (e.g. whilst the secondary location is being bootstrapped
+		// or during a failover), as such a failure to retrieve them is logged rather than failing the refresh
+		geoReplicationStats, err := retrieveStorageAccountGeoReplicationStats(ctx, client, resGroup, name, resp.Sku)
+		if err != nil {
+			log.Printf("[WARN] %+v - `geo_replication_last_sync_time` and `geo_replication_status` will be empty", err)
+		}
+		lastSyncTime, geoReplicationStatus := flattenStorageAccountGeoReplicationStats(geoReplicationStats)
+		d.Set("geo_replication_last_sync_time", lastSyncTime)
+		d.Set("geo_replication_status", geoReplicationStatus)
+
+		storageAccountsClient := meta.(*clients.Client).Storage.StorageAccountsClient
+		sftpEnabled, localUserEnabled, err := retrieveStorageAccountSftpProperties(ctx, storageAccountsClient, storageaccounts.NewStorageAccountID(id.SubscriptionID, resGroup, name))
+		if err != nil {
+			return err
+		}
+		d.Set("sftp_enabled", sftpEnabled)
+		d.Set("local_user_enabled", localUserEnabled)
+
 		if accessKeys := keys.Keys; accessKeys != nil {
 			storageAccountKeys := *accessKeys
 			if len(storageAccountKeys) > 0 {
@@ -1699,7 +1793,15 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): `sku` was nil", name, resGroup)
 	}
 
-	if resp.Sku.Tier == storage.Standard {
+	// the Queue and Static Website properties can only be retrieved from the Data Plane, which can't be accessed when
+	// Shared Key Access is disabled and AzureAD Authentication isn't enabled in the Provider block - in which case
+	// the existing values are retained, rather than failing to refresh the Storage Account
+	dataPlaneAccessAvailable := storageClient.DataPlaneAccessAvailable(*account)
+	if !dataPlaneAccessAvailable {
+		log.Printf("[DEBUG] Shared Key Access is disabled for Storage Account %q and `storage_use_azuread` isn't enabled - skipping retrieving `queue_properties` and `static_website`", name)
+	}
+
+	if resp.Sku.Tier == storage.Standard && dataPlaneAccessAvailable {
 		if resp.Kind == storage.Storage || resp.Kind == storage.StorageV2 {
 			queueClient, err := storageClient.QueuesClient(ctx, *account)
 			if err != nil {
@@ -1720,7 +1822,7 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	var staticWebsite []interface{}
 
 	// static website only supported on StorageV2 and BlockBlobStorage
-	if resp.Kind == storage.StorageV2 || resp.Kind == storage.BlockBlobStorage {
+	if (resp.Kind == storage.StorageV2 || resp.Kind == storage.BlockBlobStorage) && dataPlaneAccessAvailable {
 		storageClient := meta.(*clients.Client).Storage
 
 		account, err := storageClient.FindAccount(ctx, name)
@@ -1743,8 +1845,10 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		staticWebsite = flattenStaticWebsiteProperties(staticWebsiteProps)
 	}
 
-	if err := d.Set("static_website", staticWebsite); err != nil {
-		return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
+	if dataPlaneAccessAvailable {
+		if err := d.Set("static_website", staticWebsite); err != nil {
+			return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -2897,3 +3001,107 @@ func setEndpointAndHost(d *pluginsdk.ResourceData, ordinalString string, endpoin
 	d.Set(fmt.Sprintf("%s_%s_host", ordinalString, typeString), host)
 	return nil
 }
+
+func storageAccountIsGeoReplicated(sku *storage.Sku) bool {
+	if sku == nil {
+		return false
+	}
+
+	switch sku.Name {
+	case storage.StandardGRS, storage.StandardRAGRS, storage.StandardGZRS, storage.StandardRAGZRS:
+		return true
+	}
+
+	return false
+}
+
+// retrieveStorageAccountGeoReplicationStats returns the Geo Replication Stats for the Storage Account, which are only
+// available (and can only be expanded) for Storage Accounts replicated to a secondary location
+func retrieveStorageAccountGeoReplicationStats(ctx context.Context, client *storage.AccountsClient, resourceGroup, name string, sku *storage.Sku) (*storage.GeoReplicationStats, error) {
+	if !storageAccountIsGeoReplicated(sku) {
+		return nil, nil
+	}
+
+	resp, err := client.GetProperties(ctx, resourceGroup, name, storage.AccountExpandGeoReplicationStats)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Geo Replication Stats for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if resp.AccountProperties == nil {
+		return nil, nil
+	}
+
+	return resp.AccountProperties.GeoReplicationStats, nil
+}
+
+func flattenStorageAccountGeoReplicationStats(input *storage.GeoReplicationStats) (lastSyncTime string, status string) {
+	if input == nil {
+		return "", ""
+	}
+
+	if input.LastSyncTime != nil && !input.LastSyncTime.IsZero() {
+		lastSyncTime = input.LastSyncTime.Format(time.RFC3339)
+	}
+
+	return lastSyncTime, string(input.Status)
+}
+
+// retrieveStorageAccountSftpProperties returns whether SFTP and Local Users are enabled for the Storage Account, which
+// are only available in a newer version of the Storage API than the one used for the remainder of the Storage Account
+func retrieveStorageAccountSftpProperties(ctx context.Context, client *storageaccounts.StorageAccountsClient, id storageaccounts.StorageAccountId) (sftpEnabled bool, localUserEnabled bool, err error) {
+	// Local Users are enabled unless they've been explicitly disabled
+	localUserEnabled = true
+
+	resp, err := client.GetProperties(ctx, id)
+	if err != nil {
+		// where the newer API Version isn't available (e.g. in some Sovereign Clouds) neither feature can be used, so the
+		// API defaults are returned rather than failing to refresh the Storage Account
+		if storageAccountApiVersionUnavailable(err) {
+			log.Printf("[DEBUG] The API Version used to retrieve the SFTP properties for %s is unavailable - using the default values", id)
+			return false, true, nil
+		}
+
+		return false, false, fmt.Errorf("retrieving SFTP properties for %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if v := model.Properties.IsSftpEnabled; v != nil {
+			sftpEnabled = *v
+		}
+		if v := model.Properties.IsLocalUserEnabled; v != nil {
+			localUserEnabled = *v
+		}
+	}
+
+	return sftpEnabled, localUserEnabled, nil
+}
+
+// storageAccountApiVersionUnavailable returns whether the error is the API rejecting the requested API Version
+func storageAccountApiVersionUnavailable(err error) bool {
+	e, ok := err.(azautorest.DetailedError)
+	if !ok {
+		return false
+	}
+
+	requestErr, ok := e.Original.(*autorestAzure.RequestError)
+	if !ok || requestErr.ServiceError == nil {
+		return false
+	}
+
+	return strings.EqualFold(requestErr.ServiceError.Code, "InvalidApiVersionParameter") || strings.EqualFold(requestErr.ServiceError.Code, "NoRegisteredProviderFound")
+}
+
+func updateStorageAccountSftpProperties(ctx context.Context, client *storageaccounts.StorageAccountsClient, id storageaccounts.StorageAccountId, sftpEnabled bool, localUserEnabled bool) error {
+	input := storageaccounts.StorageAccountUpdateParameters{
+		Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+			IsSftpEnabled:      utils.Bool(sftpEnabled),
+			IsLocalUserEnabled: utils.Bool(localUserEnabled),
+		},
+	}
+
+	if _, err := client.Update(ctx, id, input); err != nil {
+		return fmt.Errorf("updating `sftp_enabled` and `local_user_enabled` for %s: %+v", id, err)
+	}
+
+	return nil
+}
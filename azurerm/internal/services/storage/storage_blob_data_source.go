@@ -64,6 +64,14 @@ func dataSourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -132,5 +140,17 @@ func dataSourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error setting `metadata`: %+v", err)
 	}
 
+	// Blob Index Tags aren't supported on Premium Storage Accounts or those with a Hierarchical Namespace (Data Lake Gen2)
+	indexTags := make(map[string]string)
+	if account.BlobIndexTagsSupported() {
+		indexTags, _, err = getStorageBlobIndexTags(ctx, blobsClient, accountName, containerName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Index Tags for Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
+		}
+	}
+	if err := d.Set("index_tags", FlattenMetaData(indexTags)); err != nil {
+		return fmt.Errorf("Error setting `index_tags`: %+v", err)
+	}
+
 	return nil
 }
@@ -36,6 +36,21 @@ func TestAccDataSourceStorageBlob_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceStorageBlob_pagePremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobDataSource{}.pagePremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("type").HasValue("Page"),
+				// Blob Index Tags aren't supported on Premium Storage Accounts
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("0"),
+			),
+		},
+	})
+}
+
 func (d StorageBlobDataSource) basic(data acceptance.TestData, fileName string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -89,3 +104,44 @@ data "azurerm_storage_blob" "test" {
 }
 `, config)
 }
+
+func (d StorageBlobDataSource) pagePremium(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "blobdstest-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsadsc%[1]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Premium"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "containerdstest-%[1]s"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Page"
+  size                   = 5120
+}
+
+data "azurerm_storage_blob" "test" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+}
+`, data.RandomString, data.Locations.Primary)
+}
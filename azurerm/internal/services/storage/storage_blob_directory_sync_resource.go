@@ -0,0 +1,343 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+func resourceStorageBlobDirectorySync() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageBlobDirectorySyncCreateUpdate,
+		Read:   resourceStorageBlobDirectorySyncRead,
+		Update: resourceStorageBlobDirectorySyncCreateUpdate,
+		Delete: resourceStorageBlobDirectorySyncDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(directorySyncCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"storage_container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			"path": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^$|^[^/](.*[^/])?$`),
+					"`path` cannot start or end with a `/`",
+				),
+			},
+
+			"source_directory": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			"manifest_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"file_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"files": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageBlobDirectorySyncCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	path := d.Get("path").(string)
+	sourceDirectory := d.Get("source_directory").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	localFiles, err := readDirectorySyncSource(sourceDirectory)
+	if err != nil {
+		return err
+	}
+
+	remoteFiles, err := listStorageBlobDirectorySyncFiles(ctx, containersClient, accountName, containerName, path)
+	if err != nil {
+		return err
+	}
+
+	operations := make([]func(ctx context.Context) error, 0)
+	for relativePath, file := range localFiles {
+		if contentMD5, ok := remoteFiles[relativePath]; ok && contentMD5 == file.ContentMD5 {
+			continue
+		}
+
+		file := file
+		blobName := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			log.Printf("[DEBUG] Uploading %q to Blob %q (Container %q / Account %q)..", file.FullPath, blobName, containerName, accountName)
+			// each file is uploaded concurrently, so the blocks within each file are uploaded by a single worker per CPU
+			upload := BlobUpload{
+				Client:        blobsClient,
+				AccountName:   accountName,
+				BlobName:      blobName,
+				ContainerName: containerName,
+				BlobType:      "block",
+				ContentType:   file.ContentType,
+				ContentMD5:    file.ContentMD5,
+				MetaData:      map[string]string{},
+				Parallelism:   1,
+				Source:        file.FullPath,
+			}
+			if err := upload.Create(ctx); err != nil {
+				return fmt.Errorf("uploading %q to Blob %q (Container %q / Account %q): %+v", file.FullPath, blobName, containerName, accountName, err)
+			}
+
+			return nil
+		})
+	}
+
+	// only Blobs which were synchronised by this resource are deleted, other Blobs within the `path` are left as-is
+	oldFiles, _ := d.GetChange("files")
+	managedFiles := directorySyncManagedFiles(oldFiles.(*pluginsdk.Set).List())
+	for relativePath := range remoteFiles {
+		if _, ok := localFiles[relativePath]; ok {
+			continue
+		}
+		if _, ok := managedFiles[relativePath]; !ok {
+			continue
+		}
+
+		blobName := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			return deleteStorageBlobDirectorySyncBlob(ctx, blobsClient, accountName, containerName, blobName)
+		})
+	}
+
+	// until the synchronisation completes both the previous and the new files are managed, so that a partial failure
+	// doesn't lose track of any Blobs which need deleting
+	pendingFiles := flattenDirectorySyncFiles(localFiles)
+	for relativePath := range managedFiles {
+		if _, ok := localFiles[relativePath]; !ok {
+			pendingFiles = append(pendingFiles, relativePath)
+		}
+	}
+	d.Set("files", pendingFiles)
+
+	log.Printf("[INFO] Synchronising %q to Container %q (Account %q) - %d operations are required..", sourceDirectory, containerName, accountName, len(operations))
+	if err := runDirectorySyncOperations(ctx, d.Get("parallelism").(int), operations); err != nil {
+		return fmt.Errorf("synchronising %q to Container %q (Account %q): %+v", sourceDirectory, containerName, accountName, err)
+	}
+
+	d.Set("files", flattenDirectorySyncFiles(localFiles))
+	d.SetId(directorySyncResourceID(containersClient.GetResourceID(accountName, containerName), path))
+
+	return resourceStorageBlobDirectorySyncRead(d, meta)
+}
+
+func resourceStorageBlobDirectorySyncRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	path := d.Get("path").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Container %q - assuming removed & removing from state!", accountName, containerName)
+		d.SetId("")
+		return nil
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	remoteFiles, err := listStorageBlobDirectorySyncFiles(ctx, containersClient, accountName, containerName, path)
+	if err != nil {
+		return err
+	}
+	if remoteFiles == nil {
+		log.Printf("[DEBUG] Container %q (Account %q) was not found - removing from state", containerName, accountName)
+		d.SetId("")
+		return nil
+	}
+	remoteFiles = filterDirectorySyncManagedFiles(remoteFiles, directorySyncManagedFiles(d.Get("files").(*pluginsdk.Set).List()))
+
+	d.Set("storage_account_name", accountName)
+	d.Set("storage_container_name", containerName)
+	d.Set("path", path)
+	d.Set("manifest_hash", directorySyncManifestHash(remoteFiles))
+	d.Set("file_count", len(remoteFiles))
+
+	return nil
+}
+
+func resourceStorageBlobDirectorySyncDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	path := d.Get("path").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	remoteFiles, err := listStorageBlobDirectorySyncFiles(ctx, containersClient, accountName, containerName, path)
+	if err != nil {
+		return err
+	}
+	remoteFiles = filterDirectorySyncManagedFiles(remoteFiles, directorySyncManagedFiles(d.Get("files").(*pluginsdk.Set).List()))
+
+	operations := make([]func(ctx context.Context) error, 0)
+	for relativePath := range remoteFiles {
+		blobName := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			return deleteStorageBlobDirectorySyncBlob(ctx, blobsClient, accountName, containerName, blobName)
+		})
+	}
+
+	if err := runDirectorySyncOperations(ctx, d.Get("parallelism").(int), operations); err != nil {
+		return fmt.Errorf("deleting synchronised Blobs from Container %q (Account %q): %+v", containerName, accountName, err)
+	}
+
+	return nil
+}
+
+// listStorageBlobDirectorySyncFiles returns the Content MD5 of each Blob within `path`, keyed by the path relative to it.
+// nil is returned if the Container doesn't exist.
+func listStorageBlobDirectorySyncFiles(ctx context.Context, client *containers.Client, accountName, containerName, path string) (map[string]string, error) {
+	input := containers.ListBlobsInput{}
+	if path != "" {
+		input.Prefix = utils.String(fmt.Sprintf("%s/", path))
+	}
+
+	output := make(map[string]string)
+	for {
+		resp, err := client.ListBlobs(ctx, accountName, containerName, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("listing Blobs in Container %q (Account %q): %s", containerName, accountName, err)
+		}
+
+		for _, blob := range resp.Blobs.Blobs {
+			contentMD5 := ""
+			if blob.Properties != nil && blob.Properties.ContentMD5 != nil {
+				contentMD5 = *blob.Properties.ContentMD5
+			}
+			output[directorySyncRelativePath(path, blob.Name)] = contentMD5
+		}
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	return output, nil
+}
+
+func deleteStorageBlobDirectorySyncBlob(ctx context.Context, client *blobs.Client, accountName, containerName, blobName string) error {
+	log.Printf("[DEBUG] Deleting Blob %q (Container %q / Account %q)..", blobName, containerName, accountName)
+	input := blobs.DeleteInput{
+		DeleteSnapshots: true,
+	}
+	if resp, err := client.Delete(ctx, accountName, containerName, blobName, input); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting Blob %q (Container %q / Account %q): %+v", blobName, containerName, accountName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+type StorageBlobDirectorySyncResource struct{}
+
+func TestAccStorageBlobDirectorySync_basic(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"js/nested/site.js": "console.log('hello');",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("3"),
+				check.That(data.ResourceName).Key("manifest_hash").Exists(),
+				data.CheckWithClient(r.blobHasContentType("index.html", "text/html; charset=utf-8")),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobDirectorySync_update(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withPath(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("2"),
+			),
+		},
+		{
+			PreConfig: func() {
+				writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+					"index.html":  "<html><body></body></html>",
+					"favicon.ico": "icon",
+				})
+				if err := os.RemoveAll(filepath.Join(sourceDirectory, "css")); err != nil {
+					t.Fatalf("removing directory: %+v", err)
+				}
+			},
+			Config: r.withPath(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("2"),
+				data.CheckWithClient(r.blobHasContentType("static/index.html", "text/html; charset=utf-8")),
+			),
+		},
+	})
+}
+
+func TestAccStorageBlobDirectorySync_unmanagedBlobs(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedBlobs(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("1"),
+				check.That(data.ResourceName).Key("files.#").HasValue("1"),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(`/test/$`)),
+				check.That("azurerm_storage_blob.unmanaged").ExistsInAzure(StorageBlobResource{}),
+			),
+		},
+		{
+			PreConfig: func() {
+				writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+					"about.html": "<html></html>",
+				})
+				if err := os.Remove(filepath.Join(sourceDirectory, "index.html")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.unmanagedBlobs(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("1"),
+				check.That(data.ResourceName).Key("files.#").HasValue("1"),
+				check.That("azurerm_storage_blob.unmanaged").ExistsInAzure(StorageBlobResource{}),
+			),
+		},
+	})
+}
+
+func (r StorageBlobDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	accountName := state.Attributes["storage_account_name"]
+	containerName := state.Attributes["storage_container_name"]
+
+	account, err := client.Storage.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for Container %q", accountName, containerName)
+	}
+	containersClient, err := client.Storage.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	input := containers.ListBlobsInput{}
+	if path := state.Attributes["path"]; path != "" {
+		input.Prefix = utils.String(fmt.Sprintf("%s/", path))
+	}
+	resp, err := containersClient.ListBlobs(ctx, accountName, containerName, input)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing Blobs in Container %q (Account %q): %+v", containerName, accountName, err)
+	}
+
+	return utils.Bool(len(resp.Blobs.Blobs) > 0), nil
+}
+
+func (r StorageBlobDirectorySyncResource) blobHasContentType(blobName, contentType string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		accountName := state.Attributes["storage_account_name"]
+		containerName := state.Attributes["storage_container_name"]
+
+		account, err := clients.Storage.FindAccount(ctx, accountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Account %q for Container %q", accountName, containerName)
+		}
+		blobsClient, err := clients.Storage.BlobsClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Blobs Client: %+v", err)
+		}
+
+		props, err := blobsClient.GetProperties(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			return fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", blobName, containerName, accountName, err)
+		}
+		if props.ContentType != contentType {
+			return fmt.Errorf("expected Blob %q to have the Content Type %q but got %q", blobName, contentType, props.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageBlobDirectorySyncResource) withPath(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  path                   = "static"
+  source_directory       = "%s"
+  parallelism            = 2
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageBlobDirectorySyncResource) unmanagedBlobs(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "unmanaged" {
+  name                   = "unmanaged.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "unmanaged"
+}
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source_directory       = "%s"
+
+  depends_on = [azurerm_storage_blob.unmanaged]
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageBlobDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+// writeDirectorySyncFiles writes the `files` (keyed by relative path) into the directory tree at `directory`
+func writeDirectorySyncFiles(t *testing.T, directory string, files map[string]string) {
+	for relativePath, content := range files {
+		path := filepath.Join(directory, filepath.FromSlash(relativePath))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", path, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("writing %q: %+v", path, err)
+		}
+	}
+}
@@ -131,7 +131,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -140,6 +139,15 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageBlobIndexTags,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -287,6 +295,15 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated MetaData for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
+	if d.HasChange("index_tags") {
+		log.Printf("[DEBUG] Updating Index Tags for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		indexTags := ExpandMetaData(d.Get("index_tags").(map[string]interface{}))
+		if _, err := setStorageBlobIndexTags(ctx, blobsClient, id.AccountName, id.ContainerName, id.BlobName, indexTags); err != nil {
+			return fmt.Errorf("Error updating Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Updated Index Tags for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
 	return resourceStorageBlobRead(d, meta)
 }
 
@@ -357,6 +374,18 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("source_uri", props.CopySource)
 	}
 
+	// Blob Index Tags aren't supported on Premium Storage Accounts or those with a Hierarchical Namespace (Data Lake Gen2)
+	indexTags := make(map[string]string)
+	if account.BlobIndexTagsSupported() {
+		indexTags, _, err = getStorageBlobIndexTags(ctx, blobsClient, id.AccountName, id.ContainerName, id.BlobName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+	}
+	if err := d.Set("index_tags", FlattenMetaData(indexTags)); err != nil {
+		return fmt.Errorf("Error setting `index_tags`: %+v", err)
+	}
+
 	return nil
 }
 
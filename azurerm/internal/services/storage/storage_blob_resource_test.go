@@ -2,9 +2,11 @@ package storage_test
 
 import (
 	"context"
+	"crypto/md5"
 	"crypto/rand"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -143,6 +145,8 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 			Config: r.blockFromInlineContent(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				// the MD5 is calculated for the Blob when `content_md5` isn't specified
+				check.That(data.ResourceName).Key("content_md5").HasValue(fmt.Sprintf("%x", md5.Sum([]byte("Wubba Lubba Dub Dub")))),
 			),
 		},
 		data.ImportStep("parallelism", "size", "source_content", "type"),
@@ -212,6 +216,8 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+				// the MD5 is calculated for the Blob when `content_md5` isn't specified
+				check.That(data.ResourceName).Key("content_md5").HasValue(fileContentMD5(t, sourceBlob.Name())),
 			),
 		},
 		data.ImportStep("parallelism", "size", "source", "type"),
@@ -243,6 +249,50 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockFromLocalFileWithIncorrectContentMd5(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.incorrectContentMd5ForLocalFile(data, sourceBlob.Name()),
+			ExpectError: regexp.MustCompile("doesn't match the `content_md5`"),
+		},
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileSingleWorker(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlobSingleWorker(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+	})
+}
+
 func TestAccStorageBlob_contentType(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -304,6 +354,16 @@ func TestAccStorageBlob_pageEmptyPremium(t *testing.T) {
 			Config: r.pageEmptyPremium(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				// Blob Index Tags aren't supported on Premium Storage Accounts
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("0"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.pageEmptyPremiumMetaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
 			),
 		},
 		data.ImportStep("parallelism", "size", "type"),
@@ -401,6 +461,39 @@ func TestAccStorageBlob_update(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_indexTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.indexTags(data, "alpha"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("2"),
+				check.That(data.ResourceName).Key("index_tags.project").HasValue("alpha"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.indexTags(data, "beta"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.project").HasValue("beta"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+		{
+			Config: r.blockEmpty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("0"),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type"),
+	})
+}
+
 func (r StorageBlobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := blobs.ParseResourceID(state.ID)
 	if err != nil {
@@ -756,6 +849,46 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName, fileName)
 }
 
+func (r StorageBlobResource) incorrectContentMd5ForLocalFile(data acceptance.TestData, fileName string) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  content_md5            = "${md5("not the contents of the file")}"
+}
+`, template, fileName)
+}
+
+func (r StorageBlobResource) blockFromLocalBlobSingleWorker(data acceptance.TestData, fileName string) string {
+	template := r.template(data, "blob")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  parallelism            = 1
+}
+`, template, fileName)
+}
+
 func (r StorageBlobResource) contentType(data acceptance.TestData) string {
 	template := r.template(data, "private")
 	return fmt.Sprintf(`
@@ -854,6 +987,29 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) pageEmptyPremiumMetaData(data acceptance.TestData) string {
+	template := r.templatePremium(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Page"
+  size                   = 5120
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, template)
+}
+
 func (r StorageBlobResource) pageEmptyMetaData(data acceptance.TestData) string {
 	template := r.template(data, "private")
 	return fmt.Sprintf(`
@@ -987,6 +1143,29 @@ resource "azurerm_storage_blob" "test" {
 `, template)
 }
 
+func (r StorageBlobResource) indexTags(data acceptance.TestData, project string) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+
+  index_tags = {
+    project     = "%s"
+    environment = "test"
+  }
+}
+`, template, project)
+}
+
 func (r StorageBlobResource) template(data acceptance.TestData, accessLevel string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -1060,6 +1239,15 @@ resource "azurerm_storage_container" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, accessLevel)
 }
 
+func fileContentMD5(t *testing.T, filePath string) string {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("Failed to read %q: %s", filePath, err)
+	}
+
+	return fmt.Sprintf("%x", md5.Sum(contents))
+}
+
 func populateTempFile(input *os.File) error {
 	if err := input.Truncate(25*1024*1024 + 512); err != nil {
 		return fmt.Errorf("Failed to truncate file to 25M")
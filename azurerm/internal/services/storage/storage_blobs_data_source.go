@@ -0,0 +1,260 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceStorageBlobs() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageBlobsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"storage_container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			"prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"delimiter": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"metadata_filter": MetaDataSchema(),
+
+			"blobs": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"size": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"content_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_modified": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"access_tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"metadata": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"url": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"prefixes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageBlobsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blobs (Container %q): %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Blobs Client: %s", err)
+	}
+
+	input := listContainerBlobsInput{
+		// the MetaData is returned for each Blob, which saves retrieving the properties of each Blob individually
+		IncludeMetaData: true,
+	}
+	if v := d.Get("prefix").(string); v != "" {
+		input.Prefix = utils.String(v)
+	}
+	if v := d.Get("delimiter").(string); v != "" {
+		input.Delimiter = utils.String(v)
+	}
+	metadataFilter := ExpandMetaData(d.Get("metadata_filter").(map[string]interface{}))
+
+	log.Printf("[INFO] Listing Blobs in Container %q / Account %q..", containerName, accountName)
+	results := make([]interface{}, 0)
+	prefixes := make([]string, 0)
+	for {
+		resp, err := listContainerBlobs(ctx, containersClient, accountName, containerName, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Container %q (Account %q) was not found", containerName, accountName)
+			}
+
+			return fmt.Errorf("listing Blobs in Container %q (Account %q): %s", containerName, accountName, err)
+		}
+
+		for _, blob := range resp.Blobs {
+			if !storageBlobMetaDataMatches(blob.MetaData, metadataFilter) {
+				continue
+			}
+
+			results = append(results, flattenStorageBlobsListItem(blob, blobsClient.GetResourceID(accountName, containerName, blob.Name)))
+		}
+
+		for _, prefix := range resp.Prefixes {
+			prefixes = append(prefixes, prefix.Name)
+		}
+
+		if resp.NextMarker == nil || *resp.NextMarker == "" {
+			break
+		}
+		input.Marker = resp.NextMarker
+	}
+
+	d.SetId(containersClient.GetResourceID(accountName, containerName))
+
+	d.Set("storage_account_name", accountName)
+	d.Set("storage_container_name", containerName)
+
+	if err := d.Set("blobs", results); err != nil {
+		return fmt.Errorf("setting `blobs`: %+v", err)
+	}
+
+	if err := d.Set("prefixes", prefixes); err != nil {
+		return fmt.Errorf("setting `prefixes`: %+v", err)
+	}
+
+	return nil
+}
+
+func storageBlobMetaDataMatches(metaData map[string]string, filter map[string]string) bool {
+	for filterKey, filterValue := range filter {
+		found := false
+		for key, value := range metaData {
+			// MetaData keys are case-insensitive
+			if strings.EqualFold(key, filterKey) && value == filterValue {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenStorageBlobsListItem(blob containerBlobDetails, url string) map[string]interface{} {
+	blobType := ""
+	accessTier := ""
+	size := 0
+	contentType := ""
+	lastModified := ""
+
+	p := blob.Properties
+	if p.BlobType != nil {
+		blobType = strings.TrimSuffix(*p.BlobType, "Blob")
+	}
+
+	if p.AccessTier != nil {
+		accessTier = *p.AccessTier
+	}
+
+	if p.ContentLength != nil {
+		size = int(*p.ContentLength)
+	}
+
+	if p.ContentType != nil {
+		contentType = *p.ContentType
+	}
+
+	if p.LastModified != nil {
+		lastModified = *p.LastModified
+		// RFC3339 can be compared/sorted, unlike the RFC1123 format returned by the API
+		if v, err := time.Parse(time.RFC1123, *p.LastModified); err == nil {
+			lastModified = v.Format(time.RFC3339)
+		}
+	}
+
+	return map[string]interface{}{
+		"name":          blob.Name,
+		"type":          blobType,
+		"size":          size,
+		"content_type":  contentType,
+		"last_modified": lastModified,
+		"access_tier":   accessTier,
+		"metadata":      FlattenMetaData(blob.MetaData),
+		"url":           url,
+	}
+}
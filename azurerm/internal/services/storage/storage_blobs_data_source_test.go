@@ -0,0 +1,203 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type StorageBlobsDataSource struct{}
+
+func TestAccDataSourceStorageBlobs_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("3"),
+				check.That(data.ResourceName).Key("prefixes.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobsDataSource{}.prefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("2"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("releases/1.0.0.txt"),
+				check.That(data.ResourceName).Key("blobs.0.type").HasValue("Block"),
+				check.That(data.ResourceName).Key("blobs.0.content_type").HasValue("text/plain"),
+				check.That(data.ResourceName).Key("blobs.0.last_modified").Exists(),
+				check.That(data.ResourceName).Key("blobs.0.url").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_delimiter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobsDataSource{}.delimiter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("README.txt"),
+				check.That(data.ResourceName).Key("prefixes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("prefixes.0").HasValue("nightly/"),
+				check.That(data.ResourceName).Key("prefixes.1").HasValue("releases/"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageBlobs_metadataFilter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blobs", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobsDataSource{}.metadataFilter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs.#").HasValue("1"),
+				check.That(data.ResourceName).Key("blobs.0.name").HasValue("releases/1.1.0.txt"),
+				check.That(data.ResourceName).Key("blobs.0.metadata.channel").HasValue("stable"),
+			),
+		},
+	})
+}
+
+func (d StorageBlobsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "artifacts"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "first" {
+  name                   = "releases/1.0.0.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "1.0.0"
+  content_type           = "text/plain"
+
+  metadata = {
+    channel = "beta"
+  }
+}
+
+resource "azurerm_storage_blob" "second" {
+  name                   = "releases/1.1.0.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "1.1.0"
+  content_type           = "text/plain"
+
+  metadata = {
+    channel = "stable"
+  }
+}
+
+resource "azurerm_storage_blob" "third" {
+  name                   = "nightly/2021-06-01.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "nightly"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (d StorageBlobsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+
+  depends_on = [azurerm_storage_blob.first, azurerm_storage_blob.second, azurerm_storage_blob.third]
+}
+`, d.template(data))
+}
+
+func (d StorageBlobsDataSource) prefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  prefix                 = "releases/"
+
+  depends_on = [azurerm_storage_blob.first, azurerm_storage_blob.second, azurerm_storage_blob.third]
+}
+`, d.template(data))
+}
+
+func (d StorageBlobsDataSource) delimiter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob" "readme" {
+  name                   = "README.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "readme"
+}
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  delimiter              = "/"
+
+  depends_on = [azurerm_storage_blob.first, azurerm_storage_blob.second, azurerm_storage_blob.third, azurerm_storage_blob.readme]
+}
+`, d.template(data))
+}
+
+func (d StorageBlobsDataSource) metadataFilter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blobs" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  prefix                 = "releases/"
+
+  metadata_filter = {
+    channel = "stable"
+  }
+
+  depends_on = [azurerm_storage_blob.first, azurerm_storage_blob.second, azurerm_storage_blob.third]
+}
+`, d.template(data))
+}
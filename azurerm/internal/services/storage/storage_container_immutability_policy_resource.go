@@ -0,0 +1,303 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageContainerImmutabilityPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerImmutabilityPolicyCreate,
+		Read:   resourceStorageContainerImmutabilityPolicyRead,
+		Update: resourceStorageContainerImmutabilityPolicyUpdate,
+		Delete: resourceStorageContainerImmutabilityPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerImmutabilityPolicyID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageContainerImmutabilityPolicyCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"immutability_period_in_days": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 146000),
+			},
+
+			"protected_append_writes_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// NOTE: locking an Immutability Policy is a one-way operation, once locked it can't be unlocked
+			// or deleted and the only change which can be made is to increase the immutability period
+			"locked": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageContainerImmutabilityPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// nothing has been locked until the resource exists
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldLocked, newLocked := d.GetChange("locked")
+	if !oldLocked.(bool) {
+		return nil
+	}
+
+	if !newLocked.(bool) {
+		return fmt.Errorf("a locked Immutability Policy cannot be unlocked")
+	}
+
+	if d.HasChange("protected_append_writes_enabled") {
+		return fmt.Errorf("`protected_append_writes_enabled` cannot be changed once the Immutability Policy has been locked")
+	}
+
+	oldPeriod, newPeriod := d.GetChange("immutability_period_in_days")
+	if newPeriod.(int) < oldPeriod.(int) {
+		return fmt.Errorf("`immutability_period_in_days` can only be increased once the Immutability Policy has been locked")
+	}
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	containerId, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStorageContainerImmutabilityPolicyID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName, containerId.BlobServiceName, containerId.ContainerName, "default")
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *containerId, err)
+	}
+	if props := container.ContainerProperties; props != nil && props.HasImmutabilityPolicy != nil && *props.HasImmutabilityPolicy {
+		return tf.ImportAsExistsError("azurerm_storage_container_immutability_policy", id.ID())
+	}
+
+	policy := storage.ImmutabilityPolicy{
+		ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+			ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+			AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+		},
+	}
+
+	resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("locked").(bool) {
+		if err := lockStorageContainerImmutabilityPolicy(ctx, client, id, resp.Etag); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	containerId := parse.NewStorageContainerResourceManagerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(container.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", containerId, *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", containerId, err)
+	}
+
+	if props := container.ContainerProperties; props == nil || props.HasImmutabilityPolicy == nil || !*props.HasImmutabilityPolicy {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("storage_container_resource_manager_id", containerId.ID())
+
+	if props := resp.ImmutabilityPolicyProperty; props != nil {
+		immutabilityPeriod := 0
+		if props.ImmutabilityPeriodSinceCreationInDays != nil {
+			immutabilityPeriod = int(*props.ImmutabilityPeriodSinceCreationInDays)
+		}
+		d.Set("immutability_period_in_days", immutabilityPeriod)
+
+		protectedAppendWritesEnabled := false
+		if props.AllowProtectedAppendWrites != nil {
+			protectedAppendWritesEnabled = *props.AllowProtectedAppendWrites
+		}
+		d.Set("protected_append_writes_enabled", protectedAppendWritesEnabled)
+
+		d.Set("locked", props.State == storage.Locked)
+		d.Set("state", string(props.State))
+	}
+
+	return nil
+}
+
+func resourceStorageContainerImmutabilityPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// every change to an Immutability Policy must match its current ETag
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil || existing.ImmutabilityPolicyProperty == nil {
+		return fmt.Errorf("retrieving %s: `etag` or `properties` was nil", *id)
+	}
+	etag := existing.Etag
+	isLocked := existing.ImmutabilityPolicyProperty.State == storage.Locked
+
+	if d.HasChanges("immutability_period_in_days", "protected_append_writes_enabled") {
+		if isLocked {
+			// a locked policy can only be extended, which is validated in the CustomizeDiff
+			policy := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+				},
+			}
+
+			resp, err := client.ExtendImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *etag, &policy)
+			if err != nil {
+				return fmt.Errorf("extending %s: %+v", *id, err)
+			}
+			etag = resp.Etag
+		} else {
+			policy := storage.ImmutabilityPolicy{
+				ImmutabilityPolicyProperty: &storage.ImmutabilityPolicyProperty{
+					ImmutabilityPeriodSinceCreationInDays: utils.Int32(int32(d.Get("immutability_period_in_days").(int))),
+					AllowProtectedAppendWrites:            utils.Bool(d.Get("protected_append_writes_enabled").(bool)),
+				},
+			}
+
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, &policy, *etag)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+			etag = resp.Etag
+		}
+	}
+
+	if d.Get("locked").(bool) && !isLocked {
+		if err := lockStorageContainerImmutabilityPolicy(ctx, client, *id, etag); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageContainerImmutabilityPolicyRead(d, meta)
+}
+
+func resourceStorageContainerImmutabilityPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerImmutabilityPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.GetImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Etag == nil || existing.ImmutabilityPolicyProperty == nil {
+		return fmt.Errorf("retrieving %s: `etag` or `properties` was nil", *id)
+	}
+
+	if existing.ImmutabilityPolicyProperty.State == storage.Locked {
+		return fmt.Errorf("deleting %s: a locked Immutability Policy cannot be deleted - it must instead be removed from the Terraform State", *id)
+	}
+
+	if _, err := client.DeleteImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *existing.Etag); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func lockStorageContainerImmutabilityPolicy(ctx context.Context, client *storage.BlobContainersClient, id parse.StorageContainerImmutabilityPolicyId, etag *string) error {
+	if etag == nil {
+		return fmt.Errorf("locking %s: `etag` was nil", id)
+	}
+
+	log.Printf("[DEBUG] Locking %s..", id)
+	if _, err := client.LockImmutabilityPolicy(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, *etag); err != nil {
+		return fmt.Errorf("locking %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageContainerLegalHold() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageContainerLegalHoldCreate,
+		Read:   resourceStorageContainerLegalHoldRead,
+		Update: resourceStorageContainerLegalHoldUpdate,
+		Delete: resourceStorageContainerLegalHoldDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		// the Legal Hold is a property of the Storage Container, so this uses the ID of the Storage Container
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageContainerResourceManagerID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_container_resource_manager_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerResourceManagerID,
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					// tags are normalized to lower case by the service
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[a-z0-9]{3,23}$`),
+						"tags must be between 3 and 23 lower case alphanumeric characters",
+					),
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageContainerLegalHoldCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Get("storage_container_resource_manager_id").(string))
+	if err != nil {
+		return err
+	}
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if props := container.ContainerProperties; props != nil && props.HasLegalHold != nil && *props.HasLegalHold {
+		return tf.ImportAsExistsError("azurerm_storage_container_legal_hold", id.ID())
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+	}
+	if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	container, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(container.Response) {
+			log.Printf("[DEBUG] %s was not found - removing Legal Hold from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	tags := make([]interface{}, 0)
+	if props := container.ContainerProperties; props != nil && props.LegalHold != nil && props.LegalHold.Tags != nil {
+		for _, tag := range *props.LegalHold.Tags {
+			if tag.Tag != nil {
+				tags = append(tags, *tag.Tag)
+			}
+		}
+	}
+
+	if len(tags) == 0 {
+		log.Printf("[DEBUG] Legal Hold for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_container_resource_manager_id", id.ID())
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageContainerLegalHoldUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		oldRaw, newRaw := d.GetChange("tags")
+		oldTags := oldRaw.(*pluginsdk.Set)
+		newTags := newRaw.(*pluginsdk.Set)
+
+		// the new tags are set prior to clearing the old ones, so that the container is never without a Legal Hold
+		if added := newTags.Difference(oldTags).List(); len(added) > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(added),
+			}
+			if _, err := client.SetLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", *id, err)
+			}
+		}
+
+		if removed := oldTags.Difference(newTags).List(); len(removed) > 0 {
+			legalHold := storage.LegalHold{
+				Tags: utils.ExpandStringSlice(removed),
+			}
+			if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+				return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+			}
+		}
+	}
+
+	return resourceStorageContainerLegalHoldRead(d, meta)
+}
+
+func resourceStorageContainerLegalHoldDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.BlobContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageContainerResourceManagerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	legalHold := storage.LegalHold{
+		Tags: utils.ExpandStringSlice(d.Get("tags").(*pluginsdk.Set).List()),
+	}
+	if _, err := client.ClearLegalHold(ctx, id.ResourceGroup, id.StorageAccountName, id.ContainerName, legalHold); err != nil {
+		return fmt.Errorf("clearing Legal Hold for %s: %+v", *id, err)
+	}
+
+	return nil
+}
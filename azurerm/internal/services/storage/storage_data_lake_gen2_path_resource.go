@@ -55,6 +55,20 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 			return []*pluginsdk.ResourceData{d}, nil
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			isDirectory := d.Get("resource").(string) == string(paths.PathResourceDirectory)
+
+			if err := ValidateDataLakeGen2AceList(d.Get("ace").(*pluginsdk.Set).List(), isDirectory); err != nil {
+				return err
+			}
+
+			if _, ok := d.GetOk("recursive_acl"); ok && !isDirectory {
+				return fmt.Errorf("`recursive_acl` can only be specified when `resource` is `directory`")
+			}
+
+			return nil
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -84,10 +98,13 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 			},
 
 			"resource": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"directory"}, false),
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(paths.PathResourceDirectory),
+					string(paths.PathResourceFile),
+				}, false),
 			},
 
 			"owner": {
@@ -134,6 +151,38 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"recursive_acl": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(setAccessControlRecursiveModeModify),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(setAccessControlRecursiveModeModify),
+								string(setAccessControlRecursiveModeSet),
+							}, false),
+						},
+
+						"batch_size": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      2000,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+
+						"continue_on_failure": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -178,6 +227,8 @@ func resourceStorageDataLakeGen2PathCreate(d *pluginsdk.ResourceData, meta inter
 	switch resourceString {
 	case "directory":
 		resource = paths.PathResourceDirectory
+	case "file":
+		resource = paths.PathResourceFile
 	default:
 		return fmt.Errorf("Unhandled resource type %q", resourceString)
 	}
@@ -288,6 +339,22 @@ func resourceStorageDataLakeGen2PathUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	// the ACL is only propagated to the existing children of this Path when the ACEs or the `recursive_acl` block change
+	if v := d.Get("recursive_acl").([]interface{}); len(v) > 0 && v[0] != nil && acl != nil && d.HasChanges("ace", "recursive_acl") {
+		recursiveAcl := v[0].(map[string]interface{})
+		input := setAccessControlRecursiveInput{
+			Mode:       setAccessControlRecursiveMode(recursiveAcl["mode"].(string)),
+			ACL:        acl.String(),
+			ForceFlag:  recursiveAcl["continue_on_failure"].(bool),
+			MaxRecords: recursiveAcl["batch_size"].(int),
+		}
+
+		log.Printf("[INFO] Setting the ACL recursively for Path %q in File System %q in Storage Account %q..", path, id.FileSystemName, id.AccountName)
+		if err := applyDataLakeGen2PathAccessControlRecursive(ctx, client, id.AccountName, id.FileSystemName, path, input); err != nil {
+			return fmt.Errorf("setting access control recursively for Path %q in File System %q in Storage Account %q: %+v", path, id.FileSystemName, id.AccountName, err)
+		}
+	}
+
 	return resourceStorageDataLakeGen2PathRead(d, meta)
 }
 
@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -112,6 +113,71 @@ func TestAccStorageDataLakeGen2Path_withOwner(t *testing.T) {
 	})
 }
 
+func TestAccStorageDataLakeGen2Path_file(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.file(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource").HasValue("file"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageDataLakeGen2Path_fileWithDefaultACE(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.fileWithDefaultACE(data),
+			ExpectError: regexp.MustCompile("an `ace` with a `scope` of `default` can only be assigned to a directory"),
+		},
+	})
+}
+
+func TestAccStorageDataLakeGen2Path_recursiveACL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.recursiveACLDisabled(data, "r-x"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// enabling `recursive_acl` with unchanged ACEs propagates the existing ACL to the children
+			Config: r.recursiveACL(data, "r-x", "set"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("recursive_acl"),
+		{
+			Config: r.recursiveACL(data, "rwx", "set"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("recursive_acl"),
+		{
+			Config: r.recursiveACL(data, "r--", "modify"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("recursive_acl"),
+	})
+}
+
 func (r StorageDataLakeGen2PathResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := paths.ParseResourceID(state.ID)
 	if err != nil {
@@ -320,6 +386,128 @@ resource "azurerm_storage_data_lake_gen2_path" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageDataLakeGen2PathResource) file(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testfile.txt"
+  resource           = "file"
+}
+`, template)
+}
+
+func (r StorageDataLakeGen2PathResource) fileWithDefaultACE(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testfile.txt"
+  resource           = "file"
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    permissions = "rwx"
+  }
+}
+`, template)
+}
+
+func (r StorageDataLakeGen2PathResource) recursiveACL(data acceptance.TestData, userPermissions, mode string) string {
+	return r.recursiveACLTemplate(data, userPermissions, fmt.Sprintf(`
+  recursive_acl {
+    mode                = "%s"
+    batch_size          = 1
+    continue_on_failure = true
+  }
+`, mode))
+}
+
+func (r StorageDataLakeGen2PathResource) recursiveACLDisabled(data acceptance.TestData, userPermissions string) string {
+	return r.recursiveACLTemplate(data, userPermissions, "")
+}
+
+func (r StorageDataLakeGen2PathResource) recursiveACLTemplate(data acceptance.TestData, userPermissions, recursiveACL string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "storage_blob_owner" {
+  role_definition_name = "Storage Blob Data Owner"
+  scope                = azurerm_resource_group.test.id
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath"
+  resource           = "directory"
+
+  ace {
+    type        = "user"
+    permissions = "%s"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+  ace {
+    scope       = "default"
+    type        = "user"
+    permissions = "%s"
+  }
+  ace {
+    scope       = "default"
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    scope       = "default"
+    type        = "other"
+    permissions = "---"
+  }
+%s}
+
+resource "azurerm_storage_data_lake_gen2_path" "child_directory" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath/child"
+  resource           = "directory"
+
+  lifecycle {
+    ignore_changes = [ace]
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.test]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child_file" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath/child/file.txt"
+  resource           = "file"
+
+  lifecycle {
+    ignore_changes = [ace]
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child_directory]
+}
+`, template, userPermissions, userPermissions, recursiveACL)
+}
+
 func (r StorageDataLakeGen2PathResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
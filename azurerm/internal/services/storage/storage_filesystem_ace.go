@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
 )
@@ -69,3 +71,28 @@ func FlattenDataLakeGen2AceList(acl accesscontrol.ACL) []interface{} {
 	}
 	return output
 }
+
+// ValidateDataLakeGen2AceList confirms the ACEs are valid for the type of Path they're assigned to, since
+// `default` ACEs can only be assigned to Directories and the `mask` and `other` ACEs can't specify an `id`
+func ValidateDataLakeGen2AceList(input []interface{}, isDirectory bool) error {
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		scope, _ := v["scope"].(string)
+		tagType, _ := v["type"].(string)
+		id, _ := v["id"].(string)
+
+		if scope == "default" && !isDirectory {
+			return fmt.Errorf("an `ace` with a `scope` of `default` can only be assigned to a directory, but got a `%s` ACE for a file", tagType)
+		}
+
+		if id != "" && (tagType == string(accesscontrol.TagTypeMask) || tagType == string(accesscontrol.TagTypeOther)) {
+			return fmt.Errorf("an `id` cannot be specified for an `ace` with a `type` of `%s`", tagType)
+		}
+	}
+
+	return nil
+}
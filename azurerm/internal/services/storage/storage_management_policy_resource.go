@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -151,6 +152,29 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 													// for issue https://github.com/terraform-providers/terraform-provider-azurerm/issues/6158
 													ValidateFunc: validation.IntBetween(0, 99999),
 												},
+												"tier_to_cool_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_archive_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"delete_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"auto_tier_to_hot_from_cool_enabled": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
 											},
 										},
 									},
@@ -217,6 +241,10 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateStorageManagementPolicyRules(d)
+		}),
 	}
 }
 
@@ -380,26 +408,11 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 	if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions", ruleIndex)); ok {
 		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob", ruleIndex)); ok {
 			baseBlob := &storage.ManagementPolicyBaseBlob{}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.TierToCool = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
-				}
-			}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.TierToArchive = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
-				}
-			}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.Delete = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
-				}
+			baseBlob.TierToCool = expandStorageManagementPolicyDateAfterModification(d, ruleIndex, "tier_to_cool")
+			baseBlob.TierToArchive = expandStorageManagementPolicyDateAfterModification(d, ruleIndex, "tier_to_archive")
+			baseBlob.Delete = expandStorageManagementPolicyDateAfterModification(d, ruleIndex, "delete")
+			if d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled", ruleIndex)).(bool) {
+				baseBlob.EnableAutoTierToHotFromCool = utils.Bool(true)
 			}
 			definition.Actions.BaseBlob = baseBlob
 		}
@@ -453,6 +466,60 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 	return rule
 }
 
+// expandStorageManagementPolicyDateAfterModification expands the condition for the Base Blob `action`, which can either
+// be based on the time since the Blob was last modified, or the time since the Blob was last accessed
+func expandStorageManagementPolicyDateAfterModification(d *pluginsdk.ResourceData, ruleIndex int, action string) *storage.DateAfterModification {
+	if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.%s_after_days_since_modification_greater_than", ruleIndex, action)); ok {
+		return &storage.DateAfterModification{
+			DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
+		}
+	}
+
+	if v := d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.%s_after_days_since_last_access_time_greater_than", ruleIndex, action)).(int); v != -1 {
+		return &storage.DateAfterModification{
+			DaysAfterLastAccessTimeGreaterThan: utils.Float(float64(v)),
+		}
+	}
+
+	return nil
+}
+
+// validateStorageManagementPolicyRules validates the conditions of the Base Blob actions at plan time, since these
+// can't be expressed using the Schema alone
+func validateStorageManagementPolicyRules(d *pluginsdk.ResourceDiff) error {
+	for ruleIndex := range d.Get("rule").([]interface{}) {
+		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob", ruleIndex)); !ok {
+			continue
+		}
+		prefix := fmt.Sprintf("rule.%d.actions.0.base_blob.0", ruleIndex)
+
+		for _, action := range []string{"tier_to_cool", "tier_to_archive", "delete"} {
+			sinceModificationKey := fmt.Sprintf("%s_after_days_since_modification_greater_than", action)
+			sinceLastAccessKey := fmt.Sprintf("%s_after_days_since_last_access_time_greater_than", action)
+			if !d.NewValueKnown(fmt.Sprintf("%s.%s", prefix, sinceModificationKey)) || !d.NewValueKnown(fmt.Sprintf("%s.%s", prefix, sinceLastAccessKey)) {
+				continue
+			}
+
+			_, sinceModificationSet := d.GetOk(fmt.Sprintf("%s.%s", prefix, sinceModificationKey))
+			if sinceModificationSet && d.Get(fmt.Sprintf("%s.%s", prefix, sinceLastAccessKey)).(int) != -1 {
+				return fmt.Errorf("only one of `%s` and `%s` can be specified", sinceModificationKey, sinceLastAccessKey)
+			}
+		}
+
+		// blobs are only moved back to the hot tier when they've been moved to cool based on their last access time
+		autoTierKey := fmt.Sprintf("%s.auto_tier_to_hot_from_cool_enabled", prefix)
+		tierToCoolKey := fmt.Sprintf("%s.tier_to_cool_after_days_since_last_access_time_greater_than", prefix)
+		if !d.NewValueKnown(autoTierKey) || !d.NewValueKnown(tierToCoolKey) {
+			continue
+		}
+		if d.Get(autoTierKey).(bool) && d.Get(tierToCoolKey).(int) == -1 {
+			return fmt.Errorf("`auto_tier_to_hot_from_cool_enabled` can only be set when `tier_to_cool_after_days_since_last_access_time_greater_than` is specified")
+		}
+	}
+
+	return nil
+}
+
 func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRule) []interface{} {
 	rules := make([]interface{}, 0)
 	if armRules == nil {
@@ -498,19 +565,36 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 				action := make(map[string]interface{})
 				armActionBaseBlob := armAction.BaseBlob
 				if armActionBaseBlob != nil {
-					baseBlob := make(map[string]interface{})
+					baseBlob := map[string]interface{}{
+						"tier_to_cool_after_days_since_last_access_time_greater_than":    -1,
+						"tier_to_archive_after_days_since_last_access_time_greater_than": -1,
+						"delete_after_days_since_last_access_time_greater_than":          -1,
+						"auto_tier_to_hot_from_cool_enabled":                             false,
+					}
 					if armActionBaseBlob.TierToCool != nil && armActionBaseBlob.TierToCool.DaysAfterModificationGreaterThan != nil {
 						intTemp := int(*armActionBaseBlob.TierToCool.DaysAfterModificationGreaterThan)
 						baseBlob["tier_to_cool_after_days_since_modification_greater_than"] = intTemp
 					}
+					if armActionBaseBlob.TierToCool != nil && armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["tier_to_cool_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan)
+					}
 					if armActionBaseBlob.TierToArchive != nil && armActionBaseBlob.TierToArchive.DaysAfterModificationGreaterThan != nil {
 						intTemp := int(*armActionBaseBlob.TierToArchive.DaysAfterModificationGreaterThan)
 						baseBlob["tier_to_archive_after_days_since_modification_greater_than"] = intTemp
 					}
+					if armActionBaseBlob.TierToArchive != nil && armActionBaseBlob.TierToArchive.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["tier_to_archive_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.TierToArchive.DaysAfterLastAccessTimeGreaterThan)
+					}
 					if armActionBaseBlob.Delete != nil && armActionBaseBlob.Delete.DaysAfterModificationGreaterThan != nil {
 						intTemp := int(*armActionBaseBlob.Delete.DaysAfterModificationGreaterThan)
 						baseBlob["delete_after_days_since_modification_greater_than"] = intTemp
 					}
+					if armActionBaseBlob.Delete != nil && armActionBaseBlob.Delete.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["delete_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.Delete.DaysAfterLastAccessTimeGreaterThan)
+					}
+					if armActionBaseBlob.EnableAutoTierToHotFromCool != nil {
+						baseBlob["auto_tier_to_hot_from_cool_enabled"] = *armActionBaseBlob.EnableAutoTierToHotFromCool
+					}
 					action["base_blob"] = []interface{}{baseBlob}
 				}
 
@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -292,6 +293,48 @@ func TestAccStorageManagementPolicy_blobIndexMatch(t *testing.T) {
 	})
 }
 
+func TestAccStorageManagementPolicy_lastAccessTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
+	r := StorageManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.lastAccessTime(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.0.actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than").HasValue("10"),
+				check.That(data.ResourceName).Key("rule.0.actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.singleAction(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageManagementPolicy_lastAccessTimeInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
+	r := StorageManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.lastAccessTimeInvalid(data, "tier_to_cool_after_days_since_modification_greater_than = 10\n        tier_to_cool_after_days_since_last_access_time_greater_than = 10"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("only one of `tier_to_cool_after_days_since_modification_greater_than` and `tier_to_cool_after_days_since_last_access_time_greater_than` can be specified"),
+		},
+		{
+			Config:      r.lastAccessTimeInvalid(data, "tier_to_cool_after_days_since_modification_greater_than = 10\n        auto_tier_to_hot_from_cool_enabled = true"),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`auto_tier_to_hot_from_cool_enabled` can only be set when `tier_to_cool_after_days_since_last_access_time_greater_than` is specified"),
+		},
+	})
+}
+
 func TestAccStorageManagementPolicy_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy", "test")
 	r := StorageManagementPolicyResource{}
@@ -777,6 +820,99 @@ resource "azurerm_storage_management_policy" "test" {
 `, r.blobIndexMatchTemplate(data))
 }
 
+func (r StorageManagementPolicyResource) lastAccessTime(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+
+  blob_properties {
+    last_access_time_enabled = true
+  }
+}
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    name    = "rule1"
+    enabled = true
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+    actions {
+      base_blob {
+        tier_to_cool_after_days_since_last_access_time_greater_than    = 10
+        tier_to_archive_after_days_since_last_access_time_greater_than = 50
+        delete_after_days_since_last_access_time_greater_than          = 100
+        auto_tier_to_hot_from_cool_enabled                             = true
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageManagementPolicyResource) lastAccessTimeInvalid(data acceptance.TestData, baseBlob string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+
+  blob_properties {
+    last_access_time_enabled = true
+  }
+}
+
+resource "azurerm_storage_management_policy" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    name    = "rule1"
+    enabled = true
+    filters {
+      prefix_match = ["container1/prefix1"]
+      blob_types   = ["blockBlob"]
+    }
+    actions {
+      base_blob {
+        %s
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, baseBlob)
+}
+
 func (r StorageManagementPolicyResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
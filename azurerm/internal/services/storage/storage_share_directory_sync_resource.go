@@ -0,0 +1,522 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/file/directories"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/file/files"
+)
+
+// storageShareFileRangeSize is the maximum size of a range which can be written to a File in a single request
+const storageShareFileRangeSize = 4 * 1024 * 1024
+
+func resourceStorageShareDirectorySync() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageShareDirectorySyncCreateUpdate,
+		Read:   resourceStorageShareDirectorySyncRead,
+		Update: resourceStorageShareDirectorySyncCreateUpdate,
+		Delete: resourceStorageShareDirectorySyncDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(directorySyncCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageShareID,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: validate.StorageShareDirectoryName,
+			},
+
+			"source_directory": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
+
+			"manifest_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"file_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"files": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceStorageShareDirectorySyncCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareId, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+	path := d.Get("path").(string)
+	sourceDirectory := d.Get("source_directory").(string)
+	parallelism := d.Get("parallelism").(int)
+
+	account, err := storageClient.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", shareId.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	localFiles, err := readDirectorySyncSource(sourceDirectory)
+	if err != nil {
+		return err
+	}
+
+	remoteFiles, remoteDirectories, err := listStorageShareDirectorySyncFiles(ctx, directoriesClient, filesClient, shareId.AccountName, shareId.Name, path, parallelism)
+	if err != nil {
+		return err
+	}
+
+	// directories have to exist before Files can be created within them
+	localDirectories := storageShareDirectorySyncDirectories(path, localFiles)
+	for _, directory := range localDirectories {
+		if _, ok := remoteDirectories[directory]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating Directory %q (File Share %q / Account %q)..", directory, shareId.Name, shareId.AccountName)
+		input := directories.CreateDirectoryInput{
+			MetaData: map[string]string{},
+		}
+		if resp, err := directoriesClient.Create(ctx, shareId.AccountName, shareId.Name, directory, input); err != nil {
+			// the parent directories of `path` may already exist
+			if !utils.ResponseWasConflict(resp) {
+				return fmt.Errorf("creating Directory %q (File Share %q / Account %q): %+v", directory, shareId.Name, shareId.AccountName, err)
+			}
+		}
+	}
+
+	operations := make([]func(ctx context.Context) error, 0)
+	for relativePath, file := range localFiles {
+		if contentMD5, ok := remoteFiles[relativePath]; ok && contentMD5 == file.ContentMD5 {
+			continue
+		}
+
+		file := file
+		remotePath := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			return uploadStorageShareDirectorySyncFile(ctx, filesClient, shareId.AccountName, shareId.Name, remotePath, file)
+		})
+	}
+
+	// only Files which were synchronised by this resource are deleted, other Files within the `path` are left as-is
+	oldFiles, _ := d.GetChange("files")
+	managedFiles := directorySyncManagedFiles(oldFiles.(*pluginsdk.Set).List())
+	removedFiles := make([]string, 0)
+	for relativePath := range remoteFiles {
+		if _, ok := localFiles[relativePath]; ok {
+			continue
+		}
+		if _, ok := managedFiles[relativePath]; !ok {
+			continue
+		}
+
+		removedFiles = append(removedFiles, relativePath)
+		remotePath := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			return deleteStorageShareDirectorySyncFile(ctx, filesClient, shareId.AccountName, shareId.Name, remotePath)
+		})
+	}
+
+	// until the synchronisation completes both the previous and the new files are managed, so that a partial failure
+	// doesn't lose track of any Files which need deleting
+	pendingFiles := flattenDirectorySyncFiles(localFiles)
+	for relativePath := range managedFiles {
+		if _, ok := localFiles[relativePath]; !ok {
+			pendingFiles = append(pendingFiles, relativePath)
+		}
+	}
+	d.Set("files", pendingFiles)
+
+	log.Printf("[INFO] Synchronising %q to File Share %q (Account %q) - %d operations are required..", sourceDirectory, shareId.Name, shareId.AccountName, len(operations))
+	if err := runDirectorySyncOperations(ctx, parallelism, operations); err != nil {
+		return fmt.Errorf("synchronising %q to File Share %q (Account %q): %+v", sourceDirectory, shareId.Name, shareId.AccountName, err)
+	}
+
+	removedDirectories := storageShareDirectorySyncRemovedDirectories(path, removedFiles, localDirectories)
+	if err := deleteStorageShareDirectorySyncDirectories(ctx, directoriesClient, shareId.AccountName, shareId.Name, removedDirectories); err != nil {
+		return err
+	}
+
+	d.Set("files", flattenDirectorySyncFiles(localFiles))
+	d.SetId(directorySyncResourceID(parse.NewStorageShareDataPlaneId(shareId.AccountName, storageClient.Environment.StorageEndpointSuffix, shareId.Name).ID(), path))
+
+	return resourceStorageShareDirectorySyncRead(d, meta)
+}
+
+func resourceStorageShareDirectorySyncRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareId, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+	path := d.Get("path").(string)
+
+	account, err := storageClient.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Storage Account for File Share %q (Account %s) - assuming removed & removing from state", shareId.Name, shareId.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	fileSharesClient, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Client: %s", err)
+	}
+
+	share, err := fileSharesClient.Get(ctx, account.ResourceGroup, shareId.AccountName, shareId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving File Share %q (Account %q): %s", shareId.Name, shareId.AccountName, err)
+	}
+	if share == nil {
+		log.Printf("[WARN] File Share %q (Account %s) was not found - assuming removed & removing from state", shareId.Name, shareId.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	remoteFiles, _, err := listStorageShareDirectorySyncFiles(ctx, directoriesClient, filesClient, shareId.AccountName, shareId.Name, path, d.Get("parallelism").(int))
+	if err != nil {
+		return err
+	}
+	remoteFiles = filterDirectorySyncManagedFiles(remoteFiles, directorySyncManagedFiles(d.Get("files").(*pluginsdk.Set).List()))
+
+	d.Set("storage_share_id", parse.NewStorageShareDataPlaneId(shareId.AccountName, storageClient.Environment.StorageEndpointSuffix, shareId.Name).ID())
+	d.Set("path", path)
+	d.Set("manifest_hash", directorySyncManifestHash(remoteFiles))
+	d.Set("file_count", len(remoteFiles))
+
+	return nil
+}
+
+func resourceStorageShareDirectorySyncDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareId, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+	path := d.Get("path").(string)
+	parallelism := d.Get("parallelism").(int)
+
+	account, err := storageClient.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", shareId.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	// only Files which were synchronised by this resource are deleted, other Files within the `path` are left as-is
+	removedFiles := make([]string, 0)
+	operations := make([]func(ctx context.Context) error, 0)
+	for relativePath := range directorySyncManagedFiles(d.Get("files").(*pluginsdk.Set).List()) {
+		removedFiles = append(removedFiles, relativePath)
+		remotePath := directorySyncRemotePath(path, relativePath)
+		operations = append(operations, func(ctx context.Context) error {
+			return deleteStorageShareDirectorySyncFile(ctx, filesClient, shareId.AccountName, shareId.Name, remotePath)
+		})
+	}
+	if err := runDirectorySyncOperations(ctx, parallelism, operations); err != nil {
+		return fmt.Errorf("deleting synchronised Files from File Share %q (Account %q): %+v", shareId.Name, shareId.AccountName, err)
+	}
+
+	// the Directory at `path` itself is left in place, since it may not have been created by this resource
+	removedDirectories := storageShareDirectorySyncRemovedDirectories(path, removedFiles, nil)
+	return deleteStorageShareDirectorySyncDirectories(ctx, directoriesClient, shareId.AccountName, shareId.Name, removedDirectories)
+}
+
+// listStorageShareDirectorySyncFiles recursively lists the Directory at `path`, returning the Content MD5 of each File
+// keyed by the path relative to `path`, and the full path of each Directory (including `path` itself, if it exists)
+func listStorageShareDirectorySyncFiles(ctx context.Context, directoriesClient *directories.Client, filesClient *files.Client, accountName, shareName, path string, parallelism int) (map[string]string, map[string]struct{}, error) {
+	remoteFiles := make(map[string]string)
+	remoteDirectories := make(map[string]struct{})
+
+	pending := []string{path}
+	for len(pending) > 0 {
+		directory := pending[0]
+		pending = pending[1:]
+
+		fileNames, directoryNames, resp, err := listShareDirectoryContents(ctx, directoriesClient, accountName, shareName, directory)
+		if err != nil {
+			// the destination Directory won't exist until the first synchronisation
+			if directory == path && utils.ResponseWasNotFound(resp) {
+				break
+			}
+
+			return nil, nil, fmt.Errorf("listing Directory %q (File Share %q / Account %q): %+v", directory, shareName, accountName, err)
+		}
+		if directory != "" {
+			remoteDirectories[directory] = struct{}{}
+		}
+
+		for _, name := range fileNames {
+			remoteFiles[directorySyncRemotePath(directory, name)] = ""
+		}
+		for _, name := range directoryNames {
+			pending = append(pending, directorySyncRemotePath(directory, name))
+		}
+	}
+
+	// the Content MD5 isn't returned when listing Files, so it's retrieved for each File
+	var mutex sync.Mutex
+	output := make(map[string]string)
+	operations := make([]func(ctx context.Context) error, 0)
+	for remotePath := range remoteFiles {
+		remotePath := remotePath
+		operations = append(operations, func(ctx context.Context) error {
+			directory, fileName := storageShareDirectorySyncSplitPath(remotePath)
+			props, err := filesClient.GetProperties(ctx, accountName, shareName, directory, fileName)
+			if err != nil {
+				// the File may have been deleted since it was listed
+				if utils.ResponseWasNotFound(props.Response) {
+					return nil
+				}
+
+				return fmt.Errorf("retrieving properties for File %q (File Share %q / Account %q): %+v", remotePath, shareName, accountName, err)
+			}
+
+			mutex.Lock()
+			output[directorySyncRelativePath(path, remotePath)] = props.ContentMD5
+			mutex.Unlock()
+			return nil
+		})
+	}
+	if err := runDirectorySyncOperations(ctx, parallelism, operations); err != nil {
+		return nil, nil, err
+	}
+
+	return output, remoteDirectories, nil
+}
+
+// storageShareDirectorySyncDirectories returns the full path of each Directory required for the `localFiles`
+// (including `path` and its parents), ordered such that parent Directories come before their children
+func storageShareDirectorySyncDirectories(path string, localFiles map[string]directorySyncFile) []string {
+	required := make(map[string]struct{})
+	addWithParents := func(directory string) {
+		for directory != "" {
+			required[directory] = struct{}{}
+			directory, _ = storageShareDirectorySyncSplitPath(directory)
+		}
+	}
+
+	addWithParents(path)
+	for relativePath := range localFiles {
+		directory, _ := storageShareDirectorySyncSplitPath(directorySyncRemotePath(path, relativePath))
+		addWithParents(directory)
+	}
+
+	output := make([]string, 0, len(required))
+	for directory := range required {
+		output = append(output, directory)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		depthI, depthJ := strings.Count(output[i], "/"), strings.Count(output[j], "/")
+		if depthI != depthJ {
+			return depthI < depthJ
+		}
+		return output[i] < output[j]
+	})
+
+	return output
+}
+
+// storageShareDirectorySyncRemovedDirectories returns the full path of each Directory within `path` which contained any
+// of the `removedFiles` (relative to `path`) and which isn't one of the `requiredDirectories`
+func storageShareDirectorySyncRemovedDirectories(path string, removedFiles []string, requiredDirectories []string) []string {
+	removed := make(map[string]struct{})
+	for _, relativePath := range removedFiles {
+		directory, _ := storageShareDirectorySyncSplitPath(relativePath)
+		for directory != "" {
+			if remotePath := directorySyncRemotePath(path, directory); !utils.SliceContainsValue(requiredDirectories, remotePath) {
+				removed[remotePath] = struct{}{}
+			}
+			directory, _ = storageShareDirectorySyncSplitPath(directory)
+		}
+	}
+
+	output := make([]string, 0, len(removed))
+	for directory := range removed {
+		output = append(output, directory)
+	}
+
+	return output
+}
+
+// storageShareDirectorySyncSplitPath splits a full path into the Directory and the name of the File (or Directory)
+func storageShareDirectorySyncSplitPath(input string) (string, string) {
+	if i := strings.LastIndex(input, "/"); i >= 0 {
+		return input[:i], input[i+1:]
+	}
+
+	return "", input
+}
+
+func uploadStorageShareDirectorySyncFile(ctx context.Context, client *files.Client, accountName, shareName, remotePath string, file directorySyncFile) error {
+	log.Printf("[DEBUG] Uploading %q to File %q (File Share %q / Account %q)..", file.FullPath, remotePath, shareName, accountName)
+	directory, fileName := storageShareDirectorySyncSplitPath(remotePath)
+
+	input := files.CreateInput{
+		ContentLength: file.Size,
+		ContentType:   utils.String(file.ContentType),
+		ContentMD5:    utils.String(file.ContentMD5),
+		MetaData:      map[string]string{},
+	}
+	if _, err := client.Create(ctx, accountName, shareName, directory, fileName, input); err != nil {
+		return fmt.Errorf("creating File %q (File Share %q / Account %q): %+v", remotePath, shareName, accountName, err)
+	}
+
+	source, err := os.Open(file.FullPath)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", file.FullPath, err)
+	}
+	defer source.Close()
+
+	// each file is uploaded concurrently, so the ranges within each file are written sequentially
+	buffer := make([]byte, storageShareFileRangeSize)
+	for offset := int64(0); offset < file.Size; offset += storageShareFileRangeSize {
+		n, err := source.ReadAt(buffer, offset)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("reading %q: %+v", file.FullPath, err)
+		}
+
+		input := files.PutByteRangeInput{
+			StartBytes: offset,
+			EndBytes:   offset + int64(n),
+			Content:    buffer[:n],
+		}
+		if _, err := client.PutByteRange(ctx, accountName, shareName, directory, fileName, input); err != nil {
+			return fmt.Errorf("uploading range %d-%d of File %q (File Share %q / Account %q): %+v", input.StartBytes, input.EndBytes, remotePath, shareName, accountName, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteStorageShareDirectorySyncFile(ctx context.Context, client *files.Client, accountName, shareName, remotePath string) error {
+	log.Printf("[DEBUG] Deleting File %q (File Share %q / Account %q)..", remotePath, shareName, accountName)
+	directory, fileName := storageShareDirectorySyncSplitPath(remotePath)
+	if resp, err := client.Delete(ctx, accountName, shareName, directory, fileName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting File %q (File Share %q / Account %q): %+v", remotePath, shareName, accountName, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteStorageShareDirectorySyncDirectories deletes the specified Directories, other than those which still contain
+// Files or Directories which aren't managed by this resource
+func deleteStorageShareDirectorySyncDirectories(ctx context.Context, client *directories.Client, accountName, shareName string, input []string) error {
+	// child Directories have to be deleted before their parents
+	sort.Slice(input, func(i, j int) bool {
+		return strings.Count(input[i], "/") > strings.Count(input[j], "/")
+	})
+
+	for _, directory := range input {
+		log.Printf("[DEBUG] Deleting Directory %q (File Share %q / Account %q)..", directory, shareName, accountName)
+		if resp, err := client.Delete(ctx, accountName, shareName, directory); err != nil {
+			if utils.ResponseWasConflict(resp) {
+				log.Printf("[DEBUG] Directory %q (File Share %q / Account %q) isn't empty - leaving in place", directory, shareName, accountName)
+				continue
+			}
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting Directory %q (File Share %q / Account %q): %+v", directory, shareName, accountName, err)
+			}
+		}
+	}
+
+	return nil
+}
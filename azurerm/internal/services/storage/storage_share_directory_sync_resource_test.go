@@ -0,0 +1,220 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type StorageShareDirectorySyncResource struct{}
+
+func TestAccStorageShareDirectorySync_basic(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html":          "<html></html>",
+		"config/app.json":     "{}",
+		"config/nested/a.txt": "hello",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("3"),
+				check.That(data.ResourceName).Key("manifest_hash").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccStorageShareDirectorySync_update(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html":      "<html></html>",
+		"config/app.json": "{}",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withPath(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("2"),
+			),
+		},
+		{
+			PreConfig: func() {
+				writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+					"index.html":     "<html><body></body></html>",
+					"logs/today.txt": "",
+					"logs/older.txt": "yesterday",
+				})
+				if err := os.RemoveAll(filepath.Join(sourceDirectory, "config")); err != nil {
+					t.Fatalf("removing directory: %+v", err)
+				}
+			},
+			Config: r.withPath(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("3"),
+			),
+		},
+	})
+}
+
+func TestAccStorageShareDirectorySync_unmanagedFiles(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	writeDirectorySyncFiles(t, sourceDirectory, map[string]string{
+		"index.html":      "<html></html>",
+		"config/app.json": "{}",
+	})
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedFiles(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("2"),
+				check.That(data.ResourceName).Key("files.#").HasValue("2"),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(`/fileshare/$`)),
+				check.That("azurerm_storage_share_file.unmanaged").ExistsInAzure(StorageShareFileResource{}),
+			),
+		},
+		{
+			PreConfig: func() {
+				if err := os.RemoveAll(filepath.Join(sourceDirectory, "config")); err != nil {
+					t.Fatalf("removing directory: %+v", err)
+				}
+			},
+			Config: r.unmanagedFiles(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file_count").HasValue("1"),
+				check.That(data.ResourceName).Key("files.#").HasValue("1"),
+				check.That("azurerm_storage_share_file.unmanaged").ExistsInAzure(StorageShareFileResource{}),
+			),
+		},
+	})
+}
+
+func (r StorageShareDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	shareId, err := parse.StorageShareDataPlaneID(state.Attributes["storage_share_id"])
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	filesClient, err := client.Storage.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	resp, err := filesClient.GetProperties(ctx, shareId.AccountName, shareId.Name, state.Attributes["path"], "index.html")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving File %q (File Share %q / Account %q): %+v", "index.html", shareId.Name, shareId.AccountName, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageShareDirectorySyncResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  source_directory = "%s"
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) withPath(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  path             = "app/content"
+  source_directory = "%s"
+  parallelism      = 2
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) unmanagedFiles(data acceptance.TestData, sourceDirectory string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "unmanaged" {
+  name             = "unmanaged.txt"
+  storage_share_id = azurerm_storage_share.test.id
+}
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  source_directory = "%s"
+
+  depends_on = [azurerm_storage_share_file.unmanaged]
+}
+`, template, sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -1,9 +1,12 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -78,10 +81,19 @@ func resourceStorageShareFile() *pluginsdk.Resource {
 			},
 
 			"source": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				ForceNew:     true,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id"},
+			},
+
+			"snapshot_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  storageValidate.StorageShareSnapshotID,
+				ConflictsWith: []string{"source"},
 			},
 
 			"metadata": MetaDataSchema(),
@@ -140,6 +152,23 @@ func resourceStorageShareFileCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_storage_share_file", id)
 	}
 
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		snapshotId, err := parse.StorageShareSnapshotDataPlaneID(v.(string))
+		if err != nil {
+			return err
+		}
+		if snapshotId.AccountName != storageShareID.AccountName {
+			return fmt.Errorf("`snapshot_id` must be a Snapshot of a Share within Storage Account %q but got %q", storageShareID.AccountName, snapshotId.AccountName)
+		}
+
+		if err := restoreStorageShareFileFromSnapshot(ctx, d, client, *snapshotId, storageShareID.Name, path, fileName); err != nil {
+			return fmt.Errorf("restoring File %q (File Share %q / Account %q) from Snapshot %q: %+v", fileName, storageShareID.Name, storageShareID.AccountName, snapshotId.Snapshot, err)
+		}
+
+		d.SetId(client.GetResourceID(storageShareID.AccountName, storageShareID.Name, path, fileName))
+		return resourceStorageShareFileRead(d, meta)
+	}
+
 	input := files.CreateInput{
 		MetaData:           ExpandMetaData(d.Get("metadata").(map[string]interface{})),
 		ContentType:        utils.String(d.Get("content_type").(string)),
@@ -334,3 +363,86 @@ func resourceStorageShareFileDelete(d *pluginsdk.ResourceData, meta interface{})
 
 	return nil
 }
+
+// restoreStorageShareFileFromSnapshot copies the File with the same path from the specified Share Snapshot and then
+// assigns the Content Properties and MetaData defined in the configuration, since these are otherwise copied from the source
+func restoreStorageShareFileFromSnapshot(ctx context.Context, d *pluginsdk.ResourceData, client *files.Client, snapshotId parse.StorageShareSnapshotDataPlaneId, shareName, path, fileName string) error {
+	sourcePath := fileName
+	if path != "" {
+		sourcePath = fmt.Sprintf("%s/%s", path, fileName)
+	}
+	source := url.URL{
+		Scheme:   "https",
+		Host:     fmt.Sprintf("%s.file.%s", snapshotId.AccountName, snapshotId.DomainSuffix),
+		Path:     fmt.Sprintf("/%s/%s", snapshotId.ShareName, sourcePath),
+		RawQuery: fmt.Sprintf("sharesnapshot=%s", url.QueryEscape(snapshotId.Snapshot)),
+	}
+
+	metaData := ExpandMetaData(d.Get("metadata").(map[string]interface{}))
+	copyInput := files.CopyInput{
+		CopySource: source.String(),
+		MetaData:   metaData,
+	}
+	if _, err := client.Copy(ctx, snapshotId.AccountName, shareName, path, fileName, copyInput); err != nil {
+		return fmt.Errorf("copying from %q: %+v", copyInput.CopySource, err)
+	}
+
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context is missing a timeout")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"success"},
+		Refresh:    storageShareFileCopyStatusRefreshFunc(ctx, client, snapshotId.AccountName, shareName, path, fileName),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the copy from %q to complete: %+v", copyInput.CopySource, err)
+	}
+
+	props, err := client.GetProperties(ctx, snapshotId.AccountName, shareName, path, fileName)
+	if err != nil {
+		return fmt.Errorf("retrieving properties: %+v", err)
+	}
+
+	input := files.SetPropertiesInput{
+		ContentType:        utils.String(d.Get("content_type").(string)),
+		ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
+		ContentDisposition: utils.String(d.Get("content_disposition").(string)),
+	}
+	// the size of the File must be specified, otherwise it's truncated
+	if props.ContentLength != nil {
+		input.ContentLength = *props.ContentLength
+	}
+	if v, ok := d.GetOk("content_md5"); ok {
+		input.ContentMD5 = utils.String(v.(string))
+	}
+	if _, err := client.SetProperties(ctx, snapshotId.AccountName, shareName, path, fileName, input); err != nil {
+		return fmt.Errorf("setting properties: %+v", err)
+	}
+
+	// when no MetaData is specified the MetaData from the source is copied, so this needs to be overwritten
+	if _, err := client.SetMetaData(ctx, snapshotId.AccountName, shareName, path, fileName, metaData); err != nil {
+		return fmt.Errorf("setting metadata: %+v", err)
+	}
+
+	return nil
+}
+
+func storageShareFileCopyStatusRefreshFunc(ctx context.Context, client *files.Client, accountName, shareName, path, fileName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		props, err := client.GetProperties(ctx, accountName, shareName, path, fileName)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving copy status: %+v", err)
+		}
+
+		status := strings.ToLower(props.CopyStatus)
+		if status != "pending" && status != "success" {
+			return nil, "", fmt.Errorf("unexpected copy status %q: %s", props.CopyStatus, props.CopyStatusDescription)
+		}
+
+		return props, status, nil
+	}
+}
@@ -114,6 +114,32 @@ func TestAccAzureRMStorageShareFile_withFile(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageShareFile_fromSnapshot(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_file", "test")
+	r := StorageShareFileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromSnapshot(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_type").HasValue("text/plain"),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.restored").HasValue("true"),
+			),
+		},
+		data.ImportStep("snapshot_id"),
+	})
+}
+
 func (StorageShareFileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := files.ParseResourceID(state.ID)
 	if err != nil {
@@ -236,3 +262,45 @@ resource "azurerm_storage_share_file" "test" {
 }
 `, r.template(data), fileName)
 }
+
+func (r StorageShareFileResource) fromSnapshot(data acceptance.TestData, fileName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_file" "source" {
+  name             = "dir"
+  storage_share_id = azurerm_storage_share.test.id
+
+  source = "%s"
+
+  metadata = {
+    hello = "world"
+  }
+}
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  triggers = {
+    file = azurerm_storage_share_file.source.id
+  }
+}
+
+resource "azurerm_storage_share" "restore" {
+  name                 = "restore"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+
+resource "azurerm_storage_share_file" "test" {
+  name             = "dir"
+  storage_share_id = azurerm_storage_share.restore.id
+  snapshot_id      = azurerm_storage_share_snapshot.test.id
+  content_type     = "text/plain"
+
+  metadata = {
+    restored = "true"
+  }
+}
+`, r.template(data), fileName)
+}
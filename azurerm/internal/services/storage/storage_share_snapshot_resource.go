@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func resourceStorageShareSnapshot() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageShareSnapshotCreate,
+		Read:   resourceStorageShareSnapshotRead,
+		Delete: resourceStorageShareSnapshotDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageShareSnapshotDataPlaneID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageShareID,
+			},
+
+			// when omitted the Share Snapshot inherits the MetaData of the Share
+			"metadata": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MetaDataKeys,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"snapshot": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageShareSnapshotCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	shareId, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", shareId.AccountName)
+	}
+
+	client, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Client: %s", err)
+	}
+
+	share, err := client.Get(ctx, account.ResourceGroup, shareId.AccountName, shareId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Share %q (Account %q / Resource Group %q): %s", shareId.Name, shareId.AccountName, account.ResourceGroup, err)
+	}
+	if share == nil {
+		return fmt.Errorf("unable to locate Share %q (Account %q / Resource Group %q)", shareId.Name, shareId.AccountName, account.ResourceGroup)
+	}
+
+	var metaData map[string]string
+	if v, ok := d.GetOk("metadata"); ok {
+		metaData = ExpandMetaData(v.(map[string]interface{}))
+	}
+
+	log.Printf("[INFO] Creating Snapshot of Share %q in Storage Account %q", shareId.Name, shareId.AccountName)
+	snapshot, err := client.CreateSnapshot(ctx, account.ResourceGroup, shareId.AccountName, shareId.Name, metaData)
+	if err != nil {
+		return fmt.Errorf("creating Snapshot of Share %q (Account %q / Resource Group %q): %+v", shareId.Name, shareId.AccountName, account.ResourceGroup, err)
+	}
+	if snapshot == nil || *snapshot == "" {
+		return fmt.Errorf("creating Snapshot of Share %q (Account %q / Resource Group %q): `snapshot` was nil", shareId.Name, shareId.AccountName, account.ResourceGroup)
+	}
+
+	id := parse.NewStorageShareSnapshotDataPlaneId(shareId.AccountName, storageClient.Environment.StorageEndpointSuffix, shareId.Name, *snapshot)
+	d.SetId(id.ID())
+
+	return resourceStorageShareSnapshotRead(d, meta)
+}
+
+func resourceStorageShareSnapshotRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageShareSnapshotDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Snapshot %q of Share %q: %s", id.AccountName, id.Snapshot, id.ShareName, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Account %q for Snapshot %q of Share %q - assuming removed & removing from state", id.AccountName, id.Snapshot, id.ShareName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+	}
+
+	props, err := client.GetSnapshot(ctx, account.ResourceGroup, id.AccountName, id.ShareName, id.Snapshot)
+	if err != nil {
+		return fmt.Errorf("retrieving Snapshot %q of Share %q (Account %q / Resource Group %q): %s", id.Snapshot, id.ShareName, id.AccountName, account.ResourceGroup, err)
+	}
+	if props == nil {
+		log.Printf("[DEBUG] Snapshot %q of Share %q was not found in Account %q / Resource Group %q - assuming removed & removing from state", id.Snapshot, id.ShareName, id.AccountName, account.ResourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_share_id", id.ShareId().ID())
+	d.Set("snapshot", id.Snapshot)
+
+	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
+		return fmt.Errorf("setting `metadata`: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageShareSnapshotDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageShareSnapshotDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Snapshot %q of Share %q: %s", id.AccountName, id.Snapshot, id.ShareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	client, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Client for Storage Account %q (Resource Group %q): %s", id.AccountName, account.ResourceGroup, err)
+	}
+
+	if err := client.DeleteSnapshot(ctx, account.ResourceGroup, id.AccountName, id.ShareName, id.Snapshot); err != nil {
+		return fmt.Errorf("deleting Snapshot %q of Share %q (Account %q / Resource Group %q): %s", id.Snapshot, id.ShareName, id.AccountName, account.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type StorageShareSnapshotResource struct{}
+
+func TestAccStorageShareSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot").Exists(),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("world"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShareSnapshot_metaData(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.metaData(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+				check.That(data.ResourceName).Key("metadata.reason").HasValue("pre-upgrade"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShareSnapshot_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.triggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func TestAccStorageShareSnapshot_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
+func (r StorageShareSnapshotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageShareSnapshotDataPlaneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Snapshot %q of Share %q: %+v", id.AccountName, id.Snapshot, id.ShareName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to determine Account %q for Snapshot %q of Share %q", id.AccountName, id.Snapshot, id.ShareName)
+	}
+
+	sharesClient, err := client.Storage.FileSharesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Client: %+v", err)
+	}
+
+	props, err := sharesClient.GetSnapshot(ctx, account.ResourceGroup, id.AccountName, id.ShareName, id.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Snapshot %q of Share %q (Account %q): %+v", id.Snapshot, id.ShareName, id.AccountName, err)
+	}
+
+	return utils.Bool(props != nil), nil
+}
+
+func (r StorageShareSnapshotResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageShareSnapshotDataPlaneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Snapshot %q of Share %q: %+v", id.AccountName, id.Snapshot, id.ShareName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to determine Account %q for Snapshot %q of Share %q", id.AccountName, id.Snapshot, id.ShareName)
+	}
+
+	sharesClient, err := client.Storage.FileSharesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Client: %+v", err)
+	}
+
+	if err := sharesClient.DeleteSnapshot(ctx, account.ResourceGroup, id.AccountName, id.ShareName, id.Snapshot); err != nil {
+		return nil, fmt.Errorf("deleting Snapshot %q of Share %q (Account %q): %+v", id.Snapshot, id.ShareName, id.AccountName, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageShareSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+}
+`, r.template(data))
+}
+
+func (r StorageShareSnapshotResource) metaData(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  metadata = {
+    reason = "pre-upgrade"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageShareSnapshotResource) triggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  triggers = {
+    release = "%s"
+  }
+}
+`, r.template(data), trigger)
+}
+
+func (r StorageShareSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// shareSnapshotTimeFormat is the format used by the Data Plane API to identify a Share Snapshot
+const shareSnapshotTimeFormat = "2006-01-02T15:04:05.0000000Z"
+
+func dataSourceStorageShareSnapshots() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageShareSnapshotsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageShareID,
+			},
+
+			"created_after": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"created_before": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"snapshots": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"snapshot": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"created_at": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"metadata": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageShareSnapshotsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	client := storageClient.FileSharesResourceManagerClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareId, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	var createdAfter, createdBefore *time.Time
+	if v := d.Get("created_after").(string); v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		createdAfter = &t
+	}
+	if v := d.Get("created_before").(string); v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		createdBefore = &t
+	}
+
+	account, err := storageClient.FindAccount(ctx, shareId.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", shareId.AccountName, shareId.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", shareId.AccountName)
+	}
+
+	// the Data Plane API doesn't support listing Share Snapshots, however Resource Manager does
+	log.Printf("[INFO] Listing Snapshots of Share %q / Account %q..", shareId.Name, shareId.AccountName)
+	snapshots := make([]storage.FileShareItem, 0)
+	iterator, err := client.ListComplete(ctx, account.ResourceGroup, shareId.AccountName, "", "", storage.ListSharesExpandSnapshots)
+	if err != nil {
+		return fmt.Errorf("listing Snapshots of Share %q (Account %q / Resource Group %q): %+v", shareId.Name, shareId.AccountName, account.ResourceGroup, err)
+	}
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.Name != nil && *item.Name == shareId.Name && item.FileShareProperties != nil && item.FileShareProperties.SnapshotTime != nil {
+			createdAt := item.FileShareProperties.SnapshotTime.ToTime()
+			include := (createdAfter == nil || createdAt.After(*createdAfter)) && (createdBefore == nil || createdAt.Before(*createdBefore))
+			if include {
+				snapshots = append(snapshots, item)
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Snapshots of Share %q (Account %q / Resource Group %q): %+v", shareId.Name, shareId.AccountName, account.ResourceGroup, err)
+		}
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].FileShareProperties.SnapshotTime.ToTime().Before(snapshots[j].FileShareProperties.SnapshotTime.ToTime())
+	})
+
+	d.SetId(shareId.ID())
+	d.Set("storage_share_id", shareId.ID())
+
+	if err := d.Set("snapshots", flattenStorageShareSnapshots(shareId, snapshots)); err != nil {
+		return fmt.Errorf("setting `snapshots`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenStorageShareSnapshots(shareId *parse.StorageShareDataPlaneId, input []storage.FileShareItem) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		createdAt := item.FileShareProperties.SnapshotTime.ToTime().UTC()
+		snapshot := createdAt.Format(shareSnapshotTimeFormat)
+
+		metaData := make(map[string]interface{})
+		for k, v := range item.FileShareProperties.Metadata {
+			if v != nil {
+				metaData[k] = *v
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":         parse.NewStorageShareSnapshotDataPlaneId(shareId.AccountName, shareId.DomainSuffix, shareId.Name, snapshot).ID(),
+			"snapshot":   snapshot,
+			"created_at": createdAt.Format(time.RFC3339),
+			"metadata":   metaData,
+		})
+	}
+
+	return results
+}
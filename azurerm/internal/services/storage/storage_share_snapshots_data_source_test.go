@@ -0,0 +1,123 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type StorageShareSnapshotsDataSource struct{}
+
+func TestAccDataSourceStorageShareSnapshots_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_snapshots", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageShareSnapshotsDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("2"),
+				check.That(data.ResourceName).Key("snapshots.0.id").Exists(),
+				check.That(data.ResourceName).Key("snapshots.0.snapshot").Exists(),
+				check.That(data.ResourceName).Key("snapshots.0.created_at").Exists(),
+				check.That(data.ResourceName).Key("snapshots.0.metadata.reason").HasValue("first"),
+				check.That(data.ResourceName).Key("snapshots.1.metadata.reason").HasValue("second"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageShareSnapshots_createdAfter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_snapshots", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageShareSnapshotsDataSource{}.createdAfter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (d StorageShareSnapshotsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+
+resource "azurerm_storage_share" "other" {
+  name                 = "other"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+
+resource "azurerm_storage_share_snapshot" "first" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  metadata = {
+    reason = "first"
+  }
+}
+
+resource "azurerm_storage_share_snapshot" "second" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  metadata = {
+    reason = "second"
+  }
+
+  depends_on = [azurerm_storage_share_snapshot.first]
+}
+
+resource "azurerm_storage_share_snapshot" "other" {
+  storage_share_id = azurerm_storage_share.other.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (d StorageShareSnapshotsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share_snapshots" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  depends_on = [
+    azurerm_storage_share_snapshot.second,
+    azurerm_storage_share_snapshot.other,
+  ]
+}
+`, d.template(data))
+}
+
+func (d StorageShareSnapshotsDataSource) createdAfter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_share_snapshots" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  created_after    = timeadd(azurerm_storage_share_snapshot.second.snapshot, "1h")
+}
+`, d.template(data))
+}
@@ -0,0 +1,16 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageAccountLocalUserName(v interface{}, _ string) (warnings []string, errors []error) {
+	input := v.(string)
+
+	if !regexp.MustCompile("^[0-9a-z]{3,64}$").MatchString(input) {
+		errors = append(errors, fmt.Errorf("storage account local user name %q must be lower-case alphanumeric, and between 3 to 64 characters", input))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageAccountLocalUserName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "ab",
+			Expected: false,
+		},
+		{
+			Input:    "abc",
+			Expected: true,
+		},
+		{
+			Input:    "sftpuser01",
+			Expected: true,
+		},
+		{
+			Input:    "SftpUser",
+			Expected: false,
+		},
+		{
+			Input:    "sftp-user",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("a", 64),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("a", 65),
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := StorageAccountLocalUserName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
@@ -2,8 +2,12 @@ package validate
 
 import (
 	"fmt"
+	"regexp"
 )
 
+// blob index tag keys and values can only contain alphanumerics, spaces and the characters `+ - . / : = _`
+var storageBlobIndexTagCharacters = regexp.MustCompile(`^[a-zA-Z0-9 +\-./:=_]*$`)
+
 func StorageBlobIndexTagName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if len(value) == 0 || len(value) > 128 {
@@ -21,3 +25,35 @@ func StorageBlobIndexTagValue(v interface{}, k string) (warnings []string, error
 	}
 	return warnings, errors
 }
+
+func StorageBlobIndexTags(v interface{}, k string) (warnings []string, errors []error) {
+	tagsMap := v.(map[string]interface{})
+
+	if len(tagsMap) > 10 {
+		errors = append(errors, fmt.Errorf("a maximum of 10 index tags can be applied to a blob: %q has %d", k, len(tagsMap)))
+	}
+
+	for key, raw := range tagsMap {
+		value, ok := raw.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("the value for the index tag %q must be a string", key))
+			continue
+		}
+
+		if _, errs := StorageBlobIndexTagName(key, fmt.Sprintf("%s key", k)); len(errs) > 0 {
+			errors = append(errors, errs...)
+		}
+		if _, errs := StorageBlobIndexTagValue(value, fmt.Sprintf("%s.%s", k, key)); len(errs) > 0 {
+			errors = append(errors, errs...)
+		}
+
+		if !storageBlobIndexTagCharacters.MatchString(key) {
+			errors = append(errors, fmt.Errorf("the index tag key %q can only contain alphanumerics, spaces and the characters `+ - . / : = _`", key))
+		}
+		if !storageBlobIndexTagCharacters.MatchString(value) {
+			errors = append(errors, fmt.Errorf("the value for the index tag %q can only contain alphanumerics, spaces and the characters `+ - . / : = _`", key))
+		}
+	}
+
+	return warnings, errors
+}
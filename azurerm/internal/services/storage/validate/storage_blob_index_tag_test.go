@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -51,3 +52,63 @@ func TestStorageBlobIndexTagValue(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageBlobIndexTags(t *testing.T) {
+	tooMany := make(map[string]interface{})
+	for i := 0; i < 11; i++ {
+		tooMany[fmt.Sprintf("tag%d", i)] = "value"
+	}
+
+	cases := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"project":     "alpha",
+				"environment": "",
+				"Path/To:Key": "a+b-c.d=e_f g",
+			},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				strings.Repeat("w", 129): "value",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"key": strings.Repeat("w", 257),
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"key#": "value",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"key": "value!",
+			},
+			Valid: false,
+		},
+		{
+			Input: tooMany,
+			Valid: false,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := StorageBlobIndexTags(tc.Input, "index_tags")
+		valid := len(errors) == 0
+		if valid != tc.Valid {
+			t.Fatalf("expected %t for %+v but got %t: %+v", tc.Valid, tc.Input, valid, errors)
+		}
+	}
+}
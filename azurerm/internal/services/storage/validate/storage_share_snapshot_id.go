@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+)
+
+func StorageShareSnapshotID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parse.StorageShareSnapshotDataPlaneID(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a Storage Share Snapshot ID: %v", k, err))
+		return
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,45 @@
+package validate
+
+import "testing"
+
+func TestStorageShareSnapshotID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// share
+			Input: "https://account1.file.core.windows.net/share1",
+			Valid: false,
+		},
+		{
+			// missing share
+			Input: "https://account1.file.core.windows.net/?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Valid: false,
+		},
+		{
+			// resource manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/fileServices/default/fileshares/share1",
+			Valid: false,
+		},
+		{
+			// valid
+			Input: "https://account1.file.core.windows.net/share1?sharesnapshot=2021-09-01T10:19:25.0000000Z",
+			Valid: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StorageShareSnapshotID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}